@@ -192,5 +192,12 @@ func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
 	db := dbm.NewMemDB()
 	cfg := encoding.MakeConfig(ModuleBasics)
 	app := New(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 5, cfg, simapp.EmptyAppOptions{})
-	return app, simapp.NewDefaultGenesisState(cfg.Codec)
+	genesisState := ModuleBasics.DefaultGenesis(cfg.Codec)
+
+	// the ibc-go testing chains send cosmos txs without fees
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.NoBaseFee = true
+	genesisState[feemarkettypes.ModuleName] = cfg.Codec.MustMarshalJSON(feemarketGenesis)
+
+	return app, genesisState
 }
@@ -0,0 +1,25 @@
+package asset
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// EndBlocker removes the token authorizations that expired by the end of the block,
+// refunds the expired settlements, pays out a batch of distribution claims and
+// allocates the next one, then records the snapshot balances changed during the
+// block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.ExpireAuthorizations(ctx)
+	k.ExpireSettlements(ctx)
+	k.PayoutDistributions(ctx)
+	k.AllocateDistributions(ctx)
+	k.RecordSnapshotBalances(ctx)
+}
@@ -0,0 +1,53 @@
+package asset
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ module.AppModule = BankAppModule{}
+
+// BankAppModule is the bank module serving its messages with the keeper
+// returned by keeper.WrapBankKeeper. The bank module migrations need the base
+// keeper, which the wrapped keeper cannot be converted back to.
+type BankAppModule struct {
+	bank.AppModule
+
+	baseKeeper bankkeeper.BaseKeeper
+	keeper     bankkeeper.Keeper
+}
+
+// NewBankAppModule creates the bank module from the base keeper and the keeper wrapping it
+func NewBankAppModule(
+	cdc codec.Codec,
+	baseKeeper bankkeeper.BaseKeeper,
+	keeper bankkeeper.Keeper,
+	accountKeeper banktypes.AccountKeeper,
+) BankAppModule {
+	return BankAppModule{
+		AppModule:  bank.NewAppModule(cdc, keeper, accountKeeper),
+		baseKeeper: baseKeeper,
+		keeper:     keeper,
+	}
+}
+
+// RegisterServices registers the bank services like the bank module does,
+// with the msg server using the wrapped keeper
+func (am BankAppModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 1 to 2: %v", err))
+	}
+
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bank from version 2 to 3: %v", err))
+	}
+}
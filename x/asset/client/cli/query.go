@@ -23,6 +23,19 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryTokens())
 	cmd.AddCommand(CmdQueryToken())
+	cmd.AddCommand(CmdQueryRedemptionRequests())
+	cmd.AddCommand(CmdQueryRoles())
+	cmd.AddCommand(CmdQueryIsFrozen())
+	cmd.AddCommand(CmdQueryFrozenAddresses())
+	cmd.AddCommand(CmdQueryAuthorizedAddresses())
+	cmd.AddCommand(CmdQueryAddressAuthorizations())
+	cmd.AddCommand(CmdQueryHolderCount())
+	cmd.AddCommand(CmdQueryIsDenied())
+	cmd.AddCommand(CmdQueryDeniedAddresses())
+	cmd.AddCommand(CmdQueryDistribution())
+	cmd.AddCommand(CmdQueryPendingClaims())
+	cmd.AddCommand(CmdQueryBalanceAt())
+	cmd.AddCommand(CmdQuerySettlement())
 
 	return cmd
 }
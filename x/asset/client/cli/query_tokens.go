@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -10,6 +11,12 @@ import (
 	"github.com/realiotech/realio-network/x/asset/types"
 )
 
+const (
+	FlagManager               = "manager"
+	FlagAuthorizationRequired = "authorization-required"
+	FlagSymbolPrefix          = "symbol-prefix"
+)
+
 func CmdQueryTokens() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tokens",
@@ -20,7 +27,23 @@ func CmdQueryTokens() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Tokens(context.Background(), &types.QueryTokensRequest{})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTokensRequest{Pagination: pageReq}
+			if req.Manager, err = cmd.Flags().GetString(FlagManager); err != nil {
+				return err
+			}
+			if req.AuthorizationRequired, err = cmd.Flags().GetString(FlagAuthorizationRequired); err != nil {
+				return err
+			}
+			if req.SymbolPrefix, err = cmd.Flags().GetString(FlagSymbolPrefix); err != nil {
+				return err
+			}
+
+			res, err := queryClient.Tokens(context.Background(), req)
 			if err != nil {
 				return err
 			}
@@ -29,7 +52,11 @@ func CmdQueryTokens() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagManager, "", "Only show tokens managed by this address")
+	cmd.Flags().String(FlagAuthorizationRequired, "", "Only show tokens with this authorization-required flag (true|false)")
+	cmd.Flags().String(FlagSymbolPrefix, "", "Only show tokens whose symbol starts with this prefix")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokens")
 
 	return cmd
 }
@@ -57,3 +84,360 @@ func CmdQueryToken() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryRedemptionRequests() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemptions [symbol]",
+		Short: "query pending redemption requests of a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryRedemptionRequestsRequest(argSymbol)
+			res, err := queryClient.RedemptionRequests(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles [symbol]",
+		Short: "query the roles delegated on a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryRolesRequest(argSymbol)
+			res, err := queryClient.Roles(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryIsFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-frozen [symbol] [address]",
+		Short: "query whether an address is frozen for a token",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argAddress := args[1]
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryIsFrozenRequest(argSymbol, argAddress)
+			res, err := queryClient.IsFrozen(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryFrozenAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen [symbol]",
+		Short: "query the frozen addresses of a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryFrozenAddressesRequest(argSymbol)
+			res, err := queryClient.FrozenAddresses(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAuthorizedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorized-addresses [symbol]",
+		Short: "query the authorized addresses of a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AuthorizedAddresses(context.Background(), types.NewQueryAuthorizedAddressesRequest(argSymbol, pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "authorized addresses")
+
+	return cmd
+}
+
+func CmdQueryAddressAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-authorizations [address]",
+		Short: "query the tokens an address is authorized for",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAddress := args[0]
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AddressAuthorizations(context.Background(), types.NewQueryAddressAuthorizationsRequest(argAddress, pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "address authorizations")
+
+	return cmd
+}
+
+func CmdQueryHolderCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holder-count [symbol]",
+		Short: "query the number of holders of a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryHolderCountRequest(argSymbol)
+			res, err := queryClient.HolderCount(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryIsDenied() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-denied [symbol] [address]",
+		Short: "query whether an address is denied for a token",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argAddress := args[1]
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryIsDeniedRequest(argSymbol, argAddress)
+			res, err := queryClient.IsDenied(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDeniedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denied-addresses [symbol]",
+		Short: "query the denied addresses of a token",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DeniedAddresses(context.Background(), types.NewQueryDeniedAddressesRequest(argSymbol, pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denied addresses")
+
+	return cmd
+}
+
+func CmdQueryDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution [id]",
+		Short: "query a distribution by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryDistributionRequest(argID)
+			res, err := queryClient.Distribution(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPendingClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-claims [address]",
+		Short: "query the distribution shares not paid out yet to an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAddress := args[0]
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingClaims(context.Background(), types.NewQueryPendingClaimsRequest(argAddress, pageReq))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending claims")
+
+	return cmd
+}
+
+func CmdQueryBalanceAt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-at [symbol] [address] [snapshot-id]",
+		Short: "query the balance of an address at a snapshot of a token",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argAddress := args[1]
+			argSnapshotID, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryBalanceAtRequest(argSymbol, argAddress, argSnapshotID)
+			res, err := queryClient.BalanceAt(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySettlement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settlement [id]",
+		Short: "query a pending settlement by id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQuerySettlementRequest(argID)
+			res, err := queryClient.Settlement(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
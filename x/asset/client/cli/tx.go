@@ -13,6 +13,18 @@ import (
 
 var DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 
+const flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+
+// packetTimeoutTimestamp returns the absolute packet timeout for a timeout
+// relative to the current time
+func packetTimeoutTimestamp(cmd *cobra.Command) (uint64, error) {
+	timeout, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(time.Now().UnixNano()) + timeout, nil
+}
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -30,6 +42,40 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAuthorizeAddress())
 	cmd.AddCommand(CmdUnAuthorizeAddress())
 	cmd.AddCommand(CmdTransferToken())
+	cmd.AddCommand(CmdMintToken())
+	cmd.AddCommand(CmdBurnToken())
+	cmd.AddCommand(CmdRequestRedemption())
+	cmd.AddCommand(CmdRedeemToken())
+	cmd.AddCommand(CmdCancelRedemption())
+	cmd.AddCommand(CmdProposeManager())
+	cmd.AddCommand(CmdAcceptManager())
+	cmd.AddCommand(CmdCancelManagerProposal())
+	cmd.AddCommand(CmdGrantRole())
+	cmd.AddCommand(CmdRevokeRole())
+	cmd.AddCommand(CmdFreezeAddress())
+	cmd.AddCommand(CmdUnfreezeAddress())
+	cmd.AddCommand(CmdPauseToken())
+	cmd.AddCommand(CmdUnpauseToken())
+	cmd.AddCommand(CmdForceTransfer())
+	cmd.AddCommand(CmdBatchAuthorize())
+	cmd.AddCommand(CmdBatchUnAuthorize())
+	cmd.AddCommand(CmdUpdateHolderLimits())
+	cmd.AddCommand(CmdSetBlackoutSchedule())
+	cmd.AddCommand(CmdSetComplianceRules())
+	cmd.AddCommand(CmdSetJurisdiction())
+	cmd.AddCommand(CmdSetRestrictionMode())
+	cmd.AddCommand(CmdDenyAddress())
+	cmd.AddCommand(CmdUndenyAddress())
+	cmd.AddCommand(CmdSetIBCEnabled())
+	cmd.AddCommand(CmdReplicateToken())
+	cmd.AddCommand(CmdSendToken())
+	cmd.AddCommand(CmdDistribute())
+	cmd.AddCommand(CmdClaimDistribution())
+	cmd.AddCommand(CmdCreateSnapshot())
+	cmd.AddCommand(CmdSplitToken())
+	cmd.AddCommand(CmdCreateSettlement())
+	cmd.AddCommand(CmdAffirmSettlement())
+	cmd.AddCommand(CmdCancelSettlement())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
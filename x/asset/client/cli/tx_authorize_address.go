@@ -2,6 +2,7 @@ package cli
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -12,6 +13,8 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const FlagExpiry = "expiry"
+
 func CmdAuthorizeAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "authorize-address [symbol] [address]",
@@ -31,6 +34,10 @@ func CmdAuthorizeAddress() *cobra.Command {
 				argSymbol,
 				argAddress,
 			)
+			msg.Expiry, err = readExpiryFlag(cmd)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -38,7 +45,22 @@ func CmdAuthorizeAddress() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagExpiry, "", "Optional RFC3339 time the authorization expires at")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// readExpiryFlag parses the optional RFC3339 expiry flag of a command.
+func readExpiryFlag(cmd *cobra.Command) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(FlagExpiry)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	expiry, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &expiry, nil
+}
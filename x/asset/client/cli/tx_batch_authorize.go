@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdBatchAuthorize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-authorize [symbol] [addresses-file]",
+		Short: "Broadcast message BatchAuthorize",
+		Long:  "Authorize every address listed in addresses-file, one address per line. Blank lines and lines starting with # are skipped.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argAddresses, err := readAddressesFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchAuthorize(
+				clientCtx.GetFromAddress().String(),
+				argSymbol,
+				argAddresses,
+			)
+			msg.Expiry, err = readExpiryFlag(cmd)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiry, "", "Optional RFC3339 time the authorizations expire at")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readAddressesFile reads one address per line from a file, skipping blank lines and # comments.
+func readAddressesFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var addresses []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		addresses = append(addresses, line)
+	}
+
+	return addresses, scanner.Err()
+}
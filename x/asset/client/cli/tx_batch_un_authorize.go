@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdBatchUnAuthorize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-un-authorize [symbol] [addresses-file]",
+		Short: "Broadcast message BatchUnAuthorize",
+		Long:  "Remove the authorization of every address listed in addresses-file, one address per line. Blank lines and lines starting with # are skipped.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argAddresses, err := readAddressesFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchUnAuthorize(
+				clientCtx.GetFromAddress().String(),
+				argSymbol,
+				argAddresses,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/realiotech/realio-network/x/asset/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCreateSettlement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-settlement [counterparty] [symbol] [amount] [counterparty-symbol] [counterparty-amount] [expiry]",
+		Short: "Broadcast message CreateSettlement",
+		Long: "Escrow an amount of a token, in whole units, delivered to the counterparty once it affirms the settlement " +
+			"and delivers its own amount of another token in return, before the RFC3339 expiry",
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argCounterparty := args[0]
+			argCreatorLeg := types.SettlementLeg{Symbol: args[1], Amount: args[2]}
+			argCounterpartyLeg := types.SettlementLeg{Symbol: args[3], Amount: args[4]}
+			argExpiry, err := time.Parse(time.RFC3339, args[5])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSettlement(
+				clientCtx.GetFromAddress().String(),
+				argCounterparty,
+				argCreatorLeg,
+				argCounterpartyLeg,
+				argExpiry,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -14,11 +14,27 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagDecimals        = "decimals"
+	FlagMaxSupply       = "max-supply"
+	FlagClawbackEnabled = "clawback-enabled"
+	FlagDescription     = "description"
+	FlagURI             = "uri"
+	FlagURIHash         = "uri-hash"
+	FlagMaxHolders      = "max-holders"
+	FlagMaxBalance      = "max-balance-per-holder"
+	FlagBlackoutWindow  = "blackout-window"
+	FlagRestrictionMode = "restriction-mode"
+	FlagIBCEnabled      = "ibc-enabled"
+)
+
 func CmdCreateToken() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-token [name] [symbol] [total] [decimals] [authorization-required]",
+		Use:   "create-token [name] [symbol] [total] [authorization-required]",
 		Short: "Broadcast message CreateToken",
-		Args:  cobra.ExactArgs(5),
+		// the command used to require a fifth argument it never read, it is
+		// still accepted so existing scripts keep working
+		Args: cobra.RangeArgs(4, 5),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argName := args[0]
 			argSymbol := args[1]
@@ -40,6 +56,58 @@ func CmdCreateToken() *cobra.Command {
 				argTotal,
 				argAuthorizationRequired,
 			)
+			msg.Decimals, err = cmd.Flags().GetString(FlagDecimals)
+			if err != nil {
+				return err
+			}
+			msg.MaxSupply, err = cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			msg.ClawbackEnabled, err = cmd.Flags().GetBool(FlagClawbackEnabled)
+			if err != nil {
+				return err
+			}
+			msg.Description, err = cmd.Flags().GetString(FlagDescription)
+			if err != nil {
+				return err
+			}
+			msg.Uri, err = cmd.Flags().GetString(FlagURI)
+			if err != nil {
+				return err
+			}
+			msg.UriHash, err = cmd.Flags().GetString(FlagURIHash)
+			if err != nil {
+				return err
+			}
+			msg.MaxHolders, err = cmd.Flags().GetUint64(FlagMaxHolders)
+			if err != nil {
+				return err
+			}
+			msg.MaxBalancePerHolder, err = cmd.Flags().GetString(FlagMaxBalance)
+			if err != nil {
+				return err
+			}
+			blackoutWindows, err := cmd.Flags().GetStringArray(FlagBlackoutWindow)
+			if err != nil {
+				return err
+			}
+			if msg.BlackoutWindows, err = parseBlackoutWindows(blackoutWindows); err != nil {
+				return err
+			}
+			msg.IbcEnabled, err = cmd.Flags().GetBool(FlagIBCEnabled)
+			if err != nil {
+				return err
+			}
+			restrictionMode, err := cmd.Flags().GetString(FlagRestrictionMode)
+			if err != nil {
+				return err
+			}
+			if restrictionMode != "" {
+				if msg.RestrictionMode, err = parseRestrictionMode(restrictionMode); err != nil {
+					return err
+				}
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -47,6 +115,17 @@ func CmdCreateToken() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDecimals, "", "Optional exponent between the token and its base denom, 18 when omitted")
+	cmd.Flags().String(FlagMaxSupply, "", "Optional cap on the total supply of the token")
+	cmd.Flags().Bool(FlagClawbackEnabled, false, "Permanently allow the token controller to force transfers")
+	cmd.Flags().String(FlagDescription, "", "Optional description of the token")
+	cmd.Flags().String(FlagURI, "", "Optional URI to a document describing the token")
+	cmd.Flags().String(FlagURIHash, "", "Optional sha256 hash of the document at --uri")
+	cmd.Flags().Uint64(FlagMaxHolders, 0, "Optional cap on the number of holders of the token")
+	cmd.Flags().String(FlagMaxBalance, "", "Optional cap on the balance of each holder in whole units")
+	cmd.Flags().StringArray(FlagBlackoutWindow, nil, "Optional RFC3339 start/end window blocking transfers, such as a lockup, can be repeated")
+	cmd.Flags().String(FlagRestrictionMode, "", "Optional restriction mode (open, allowlist or denylist), overrides [authorization-required]")
+	cmd.Flags().Bool(FlagIBCEnabled, false, "Allow the token to be sent over IBC transfer when authorization is required")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/realiotech/realio-network/x/asset/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdDistribute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute [symbol] [amount] [hold|forfeit]",
+		Short: "Broadcast message Distribute",
+		Long:  "Distribute an amount, such as 1000ario, pro rata to the holders of a token",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argAmount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			argPolicy, err := parseDistributionPolicy(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDistribute(
+				clientCtx.GetFromAddress().String(),
+				argSymbol,
+				argAmount.Denom,
+				argAmount.Amount.String(),
+				argPolicy,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseDistributionPolicy parses a distribution policy given as hold or forfeit.
+func parseDistributionPolicy(arg string) (types.DistributionPolicy, error) {
+	policy, ok := types.DistributionPolicy_value["DISTRIBUTION_POLICY_"+strings.ToUpper(arg)]
+	if !ok {
+		return types.DistributionPolicyHold, fmt.Errorf("invalid distribution policy %q, expected hold or forfeit", arg)
+	}
+	return types.DistributionPolicy(policy), nil
+}
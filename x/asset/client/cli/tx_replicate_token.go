@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/realiotech/realio-network/x/asset/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdReplicateToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replicate-token [symbol] [channel]",
+		Short: "Replicate a token to the chain at the other end of an asset channel",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argChannel := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := packetTimeoutTimestamp(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReplicateToken(
+				clientCtx.GetFromAddress().String(),
+				argSymbol,
+				argChannel,
+				timeoutTimestamp,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds, relative to the current time")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetBlackoutSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-blackout-schedule [symbol] [start/end]...",
+		Short: "Broadcast message SetBlackoutSchedule",
+		Long: `Replace the blackout windows of a token. Each window is given as two RFC3339 times separated by a slash,
+transfers are blocked from the start until the end. Passing no window clears the schedule.
+
+Example:
+$ realio-networkd tx asset set-blackout-schedule rst 2024-03-01T00:00:00Z/2024-03-15T00:00:00Z`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argWindows, err := parseBlackoutWindows(args[1:])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBlackoutSchedule(
+				clientCtx.GetFromAddress().String(),
+				argSymbol,
+				argWindows,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseBlackoutWindows parses windows written as RFC3339 start/end pairs.
+func parseBlackoutWindows(values []string) ([]types.BlackoutWindow, error) {
+	windows := make([]types.BlackoutWindow, 0, len(values))
+	for _, value := range values {
+		start, end, found := strings.Cut(value, "/")
+		if !found {
+			return nil, fmt.Errorf("blackout window %s must be written as start/end", value)
+		}
+		startTime, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return nil, err
+		}
+		endTime, err := time.Parse(time.RFC3339, end)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, types.BlackoutWindow{Start: startTime, End: endTime})
+	}
+	return windows, nil
+}
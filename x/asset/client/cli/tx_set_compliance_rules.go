@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetComplianceRules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-compliance-rules [symbol] [id[=params]]...",
+		Short: "Broadcast message SetComplianceRules",
+		Long: `Replace the compliance rules checked in order on every transfer of a token, after the mandatory
+blackout, freeze, authorization and max_balance rules. Passing no rule leaves only the mandatory rules.
+
+Example:
+$ realio-networkd tx asset set-compliance-rules rst jurisdiction=US,CA`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argRules := make([]types.ComplianceRuleConfig, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				id, params, _ := strings.Cut(arg, "=")
+				argRules = append(argRules, types.ComplianceRuleConfig{Id: id, Params: params})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetComplianceRules(
+				clientCtx.GetFromAddress().String(),
+				argSymbol,
+				argRules,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/realiotech/realio-network/x/asset/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSetRestrictionMode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-restriction-mode [symbol] [open|allowlist|denylist]",
+		Short: "Broadcast message SetRestrictionMode",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argMode, err := parseRestrictionMode(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRestrictionMode(
+				clientCtx.GetFromAddress().String(),
+				argSymbol,
+				argMode,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseRestrictionMode parses a restriction mode given as open, allowlist or
+// denylist.
+func parseRestrictionMode(arg string) (types.RestrictionMode, error) {
+	mode, ok := types.RestrictionMode_value["RESTRICTION_MODE_"+strings.ToUpper(arg)]
+	if !ok || mode == int32(types.RestrictionModeUnspecified) {
+		return types.RestrictionModeUnspecified, fmt.Errorf("invalid restriction mode %q, expected open, allowlist or denylist", arg)
+	}
+	return types.RestrictionMode(mode), nil
+}
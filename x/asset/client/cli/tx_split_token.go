@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdSplitToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-token [symbol] [numerator] [denominator]",
+		Short: "Broadcast message SplitToken",
+		Long:  "Rescale every balance of a token by numerator/denominator, such as 2 1 for a 2-for-1 split or 1 10 for a 1-for-10 reverse split",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argNumerator, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argDenominator, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSplitToken(
+				clientCtx.GetFromAddress().String(),
+				argSymbol,
+				argNumerator,
+				argDenominator,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdUpdateHolderLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-holder-limits [symbol] [max-holders] [max-balance-per-holder]",
+		Short: "Broadcast message UpdateHolderLimits",
+		Long:  "Set the holder limits of a token, a max-holders of 0 and an empty max-balance-per-holder (\"\") remove the limits",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSymbol := args[0]
+			argMaxHolders, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argMaxBalancePerHolder := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateHolderLimits(
+				clientCtx.GetFromAddress().String(),
+				argSymbol,
+				argMaxHolders,
+				argMaxBalancePerHolder,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
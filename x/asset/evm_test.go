@@ -0,0 +1,350 @@
+package asset_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/asm"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/evmos/ethermint/x/evm/vm/geth"
+
+	"github.com/realiotech/realio-network/testutil"
+	"github.com/realiotech/realio-network/x/asset"
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// callEVM calls a contract from an account. Like an EVM transaction, the
+// state of a successful call is committed before its logs are settled, and
+// nothing is kept when the settlement fails.
+func (suite *GenesisTestSuite) callEVM(from sdk.AccAddress, to common.Address, input []byte) ([]byte, error) {
+	ctx, write := suite.ctx.CacheContext()
+	stateDB := statedb.New(ctx, suite.app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GasLimit:    10_000_000,
+		BlockNumber: big.NewInt(ctx.BlockHeight()),
+		Time:        big.NewInt(ctx.BlockTime().Unix()),
+		Difficulty:  big.NewInt(0),
+	}
+	chainConfig := evmtypes.DefaultChainConfig().EthereumConfig(big.NewInt(3301))
+	evm := geth.NewEVM(blockCtx, vm.TxContext{}, stateDB, chainConfig, vm.Config{}, nil)
+
+	ret, _, err := evm.Call(vm.AccountRef(common.BytesToAddress(from)), to, input, 1_000_000, big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	suite.Require().NoError(stateDB.Commit())
+
+	if err := suite.app.AssetKeeper.EVMHooks().PostTxProcessing(ctx, nil, &ethtypes.Receipt{Logs: stateDB.Logs()}); err != nil {
+		return nil, err
+	}
+	write()
+	return ret, nil
+}
+
+// callERC20 calls an ERC-20 facade from an account
+func (suite *GenesisTestSuite) callERC20(from sdk.AccAddress, symbol string, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := types.ERC20ABI.Pack(method, args...)
+	suite.Require().NoError(err)
+	ret, err := suite.callEVM(from, types.ERC20Address(symbol), input)
+	if err != nil {
+		return nil, err
+	}
+	return types.ERC20ABI.Unpack(method, ret)
+}
+
+// deployForwarder deploys a contract forwarding its calls to target and
+// returning or reverting with the result
+func (suite *GenesisTestSuite) deployForwarder(target common.Address) common.Address {
+	src := fmt.Sprintf(`
+		CALLDATASIZE
+		PUSH 0x00
+		PUSH 0x00
+		CALLDATACOPY
+		PUSH 0x00
+		PUSH 0x00
+		CALLDATASIZE
+		PUSH 0x00
+		PUSH 0x00
+		PUSH 0x%x
+		GAS
+		CALL
+		RETURNDATASIZE
+		PUSH 0x00
+		PUSH 0x00
+		RETURNDATACOPY
+		JUMPI @ok
+		RETURNDATASIZE
+		PUSH 0x00
+		REVERT
+	ok:
+		RETURNDATASIZE
+		PUSH 0x00
+		RETURN
+	`, target.Bytes())
+	compiler := asm.NewCompiler(false)
+	compiler.Feed(asm.Lex([]byte(src), false))
+	code, errs := compiler.Compile()
+	suite.Require().Empty(errs)
+
+	address := common.BytesToAddress(testutil.GenAddress())
+	stateDB := statedb.New(suite.ctx, suite.app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.ctx.HeaderHash())))
+	stateDB.SetCode(address, common.FromHex(code))
+	suite.Require().NoError(stateDB.Commit())
+	return address
+}
+
+func (suite *GenesisTestSuite) TestERC20Facade() {
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmtypes.DefaultParams()))
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := testutil.GenAddress()
+	holder := testutil.GenAddress()
+	outsider := testutil.GenAddress()
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager.String(), Name: "realio security token", Symbol: "RST", Total: "1000", AuthorizationRequired: true})
+	suite.Require().NoError(err)
+	_, err = srv.AuthorizeAddress(wctx, types.NewMsgAuthorizeAddress(manager.String(), "RST", holder.String()))
+	suite.Require().NoError(err)
+	asset.EndBlocker(suite.ctx, suite.app.AssetKeeper)
+
+	balance := func(address sdk.AccAddress) *big.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, address, "arst").Amount.BigInt()
+	}
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, "arst").Amount.BigInt()
+
+	// the facade serves the token metadata and the bank balances
+	res, err := suite.callERC20(holder, "RST", "name")
+	suite.Require().NoError(err)
+	suite.Require().Equal("realio security token", res[0])
+	res, err = suite.callERC20(holder, "RST", "symbol")
+	suite.Require().NoError(err)
+	suite.Require().Equal("rst", res[0])
+	res, err = suite.callERC20(holder, "RST", "totalSupply")
+	suite.Require().NoError(err)
+	suite.Require().Equal(supply, res[0])
+	res, err = suite.callERC20(holder, "RST", "balanceOf", common.BytesToAddress(manager))
+	suite.Require().NoError(err)
+	suite.Require().Equal(balance(manager), res[0])
+
+	// transfers move the bank balances
+	managerBalance := balance(manager)
+	res, err = suite.callERC20(manager, "RST", "transfer", common.BytesToAddress(holder), big.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(true, res[0])
+	suite.Require().Equal(new(big.Int).Sub(managerBalance, big.NewInt(100)), balance(manager))
+	suite.Require().Equal(big.NewInt(100), balance(holder))
+
+	// transfers are subject to the token restrictions
+	_, err = suite.callERC20(manager, "RST", "transfer", common.BytesToAddress(outsider), big.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+	suite.Require().Equal(big.NewInt(0), balance(outsider))
+
+	// transfers cannot exceed the balance shown by the facade
+	_, err = suite.callERC20(holder, "RST", "transfer", common.BytesToAddress(manager), big.NewInt(101))
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+
+	// balances changed outside of the EVM reach the facade right away
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: holder.String(), To: manager.String(), Amount: "40"})
+	suite.Require().NoError(err)
+	res, err = suite.callERC20(holder, "RST", "balanceOf", common.BytesToAddress(holder))
+	suite.Require().NoError(err)
+	suite.Require().Equal(balance(holder), res[0])
+	res, err = suite.callERC20(holder, "RST", "balanceOf", common.BytesToAddress(manager))
+	suite.Require().NoError(err)
+	suite.Require().Equal(balance(manager), res[0])
+}
+
+func (suite *GenesisTestSuite) TestERC20FacadeFromContract() {
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmtypes.DefaultParams()))
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := testutil.GenAddress()
+	holder := testutil.GenAddress()
+	outsider := testutil.GenAddress()
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager.String(), Name: "realio security token", Symbol: "RST", Total: "1000", AuthorizationRequired: true})
+	suite.Require().NoError(err)
+	forwarder := suite.deployForwarder(types.ERC20Address("RST"))
+	for _, address := range []sdk.AccAddress{holder, forwarder.Bytes()} {
+		_, err = srv.AuthorizeAddress(wctx, types.NewMsgAuthorizeAddress(manager.String(), "RST", address.String()))
+		suite.Require().NoError(err)
+	}
+
+	balance := func(address sdk.AccAddress) *big.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, address, "arst").Amount.BigInt()
+	}
+	call := func(method string, args ...interface{}) ([]interface{}, error) {
+		input, err := types.ERC20ABI.Pack(method, args...)
+		suite.Require().NoError(err)
+		ret, err := suite.callEVM(holder, forwarder, input)
+		if err != nil {
+			return nil, err
+		}
+		return types.ERC20ABI.Unpack(method, ret)
+	}
+
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager.String(), To: sdk.AccAddress(forwarder.Bytes()).String(), Amount: "1000"})
+	suite.Require().NoError(err)
+
+	// contracts read the bank balances through the facade
+	res, err := call("balanceOf", forwarder)
+	suite.Require().NoError(err)
+	suite.Require().Equal(balance(forwarder.Bytes()), res[0])
+	res, err = call("totalSupply")
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.BankKeeper.GetSupply(suite.ctx, "arst").Amount.BigInt(), res[0])
+
+	// transfers made by contracts move the bank balances of the contract
+	forwarderBalance := balance(forwarder.Bytes())
+	res, err = call("transfer", common.BytesToAddress(holder), big.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(true, res[0])
+	suite.Require().Equal(new(big.Int).Sub(forwarderBalance, big.NewInt(100)), balance(forwarder.Bytes()))
+	suite.Require().Equal(big.NewInt(100), balance(holder))
+	res, err = call("balanceOf", common.BytesToAddress(holder))
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(100), res[0])
+
+	// and are subject to the token restrictions
+	_, err = call("transfer", common.BytesToAddress(outsider), big.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+	suite.Require().Equal(big.NewInt(0), balance(outsider))
+	res, err = call("balanceOf", common.BytesToAddress(outsider))
+	suite.Require().NoError(err)
+	suite.Require().Zero(res[0].(*big.Int).Sign())
+
+	// a transfer exceeding the balance reverts the calling contract
+	_, err = call("transfer", common.BytesToAddress(holder), new(big.Int).Add(balance(forwarder.Bytes()), big.NewInt(1)))
+	suite.Require().ErrorIs(err, vm.ErrExecutionReverted)
+}
+
+// callAssetRegistry calls the asset registry from an account or a contract
+func (suite *GenesisTestSuite) callAssetRegistry(from sdk.AccAddress, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := types.AssetRegistryABI.Pack(method, args...)
+	suite.Require().NoError(err)
+	ret, err := suite.callEVM(from, types.AssetRegistryAddress, input)
+	if err != nil {
+		return nil, err
+	}
+	return types.AssetRegistryABI.Unpack(method, ret)
+}
+
+func (suite *GenesisTestSuite) TestAssetRegistry() {
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmtypes.DefaultParams()))
+	// the test genesis leaves the module out
+	suite.Require().NoError(suite.app.AssetKeeper.ResetAssetRegistry(suite.ctx))
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := testutil.GenAddress()
+	holder := testutil.GenAddress()
+	outsider := testutil.GenAddress()
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager.String(), Name: "realio security token", Symbol: "RST", Total: "1000", AuthorizationRequired: true})
+	suite.Require().NoError(err)
+	msg := types.NewMsgAuthorizeAddress(manager.String(), "RST", holder.String())
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	msg.Expiry = &expiry
+	_, err = srv.AuthorizeAddress(wctx, msg)
+	suite.Require().NoError(err)
+
+	// contracts read the tokens and authorizations of the module
+	res, err := suite.callAssetRegistry(outsider, "getToken", "RST")
+	suite.Require().NoError(err)
+	suite.Require().Equal(common.BytesToAddress(manager), res[0])
+	suite.Require().Equal(types.ERC20Address("RST"), res[1])
+	suite.Require().Equal(uint8(18), res[2])
+	suite.Require().Equal(false, res[3])
+	suite.Require().Equal(uint8(types.RestrictionModeAllowlist), res[4])
+	for _, symbol := range []string{"RST", "rst"} {
+		res, err = suite.callAssetRegistry(outsider, "isAuthorized", symbol, common.BytesToAddress(holder))
+		suite.Require().NoError(err)
+		suite.Require().Equal(true, res[0])
+	}
+	res, err = suite.callAssetRegistry(outsider, "isAuthorized", "RST", common.BytesToAddress(outsider))
+	suite.Require().NoError(err)
+	suite.Require().Equal(false, res[0])
+
+	// and follow their changes
+	_, err = srv.PauseToken(wctx, types.NewMsgPauseToken(manager.String(), "RST"))
+	suite.Require().NoError(err)
+	res, err = suite.callAssetRegistry(outsider, "getToken", "RST")
+	suite.Require().NoError(err)
+	suite.Require().Equal(true, res[3])
+	suite.ctx = suite.ctx.WithBlockTime(expiry)
+	res, err = suite.callAssetRegistry(outsider, "isAuthorized", "RST", common.BytesToAddress(holder))
+	suite.Require().NoError(err)
+	suite.Require().Equal(false, res[0])
+
+	// unknown tokens are returned empty
+	res, err = suite.callAssetRegistry(outsider, "getToken", "usd")
+	suite.Require().NoError(err)
+	suite.Require().Equal(common.Address{}, res[0])
+
+	// the manager authorizes and unauthorizes addresses
+	_, err = suite.callAssetRegistry(manager, "authorize", "RST", common.BytesToAddress(outsider))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", outsider))
+	res, err = suite.callAssetRegistry(holder, "isAuthorized", "RST", common.BytesToAddress(outsider))
+	suite.Require().NoError(err)
+	suite.Require().Equal(true, res[0])
+	_, err = suite.callAssetRegistry(manager, "unauthorize", "RST", common.BytesToAddress(outsider))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", outsider))
+
+	// other callers revert the EVM transaction
+	_, err = suite.callAssetRegistry(outsider, "authorize", "RST", common.BytesToAddress(outsider))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().False(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", outsider))
+}
+
+func (suite *GenesisTestSuite) TestAssetRegistryFromContract() {
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmtypes.DefaultParams()))
+	// the test genesis leaves the module out
+	suite.Require().NoError(suite.app.AssetKeeper.ResetAssetRegistry(suite.ctx))
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := testutil.GenAddress()
+	holder := testutil.GenAddress()
+	forwarder := suite.deployForwarder(types.AssetRegistryAddress)
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager.String(), Name: "realio security token", Symbol: "RST", Total: "1000", AuthorizationRequired: true})
+	suite.Require().NoError(err)
+	call := func(method string, args ...interface{}) ([]interface{}, error) {
+		input, err := types.AssetRegistryABI.Pack(method, args...)
+		suite.Require().NoError(err)
+		ret, err := suite.callEVM(holder, forwarder, input)
+		if err != nil {
+			return nil, err
+		}
+		return types.AssetRegistryABI.Unpack(method, ret)
+	}
+
+	// a contract can only authorize addresses with the compliance role
+	_, err = call("authorize", "RST", common.BytesToAddress(holder))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.GrantRole(wctx, types.NewMsgGrantRole(manager.String(), "RST", sdk.AccAddress(forwarder.Bytes()).String(), types.RoleCompliance))
+	suite.Require().NoError(err)
+	_, err = call("authorize", "RST", common.BytesToAddress(holder))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", holder))
+
+	// and reads them back through the registry
+	res, err := call("isAuthorized", "RST", common.BytesToAddress(holder))
+	suite.Require().NoError(err)
+	suite.Require().Equal(true, res[0])
+}
@@ -14,6 +14,69 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, token := range genState.Tokens {
 		k.SetToken(ctx, token)
 	}
+	for _, redemption := range genState.Redemptions {
+		k.SetRedemptionRequest(ctx, redemption)
+	}
+	for _, role := range genState.Roles {
+		k.SetRole(ctx, role)
+	}
+	for _, frozen := range genState.Frozen {
+		k.SetFrozenAddress(ctx, frozen)
+	}
+	for _, authorization := range genState.Authorizations {
+		k.SetAuthorization(ctx, authorization)
+	}
+	for _, jurisdiction := range genState.Jurisdictions {
+		k.SetAddressJurisdiction(ctx, jurisdiction)
+	}
+	for _, denied := range genState.Denied {
+		k.SetDeniedAddress(ctx, denied)
+	}
+	for _, escrow := range genState.Escrows {
+		k.SetChannelEscrow(ctx, escrow)
+	}
+	for _, distribution := range genState.Distributions {
+		k.SetDistribution(ctx, distribution)
+	}
+	for _, claim := range genState.DistributionClaims {
+		k.SetDistributionClaim(ctx, claim)
+	}
+	for _, claim := range genState.SettledDistributionClaims {
+		k.SetSettledDistributionClaim(ctx, claim)
+	}
+	k.SetDistributionCount(ctx, genState.DistributionCount)
+	for _, snapshot := range genState.Snapshots {
+		k.SetSnapshot(ctx, snapshot)
+	}
+	for _, balance := range genState.SnapshotBalances {
+		k.SetSnapshotBalance(ctx, balance)
+	}
+	for _, settlement := range genState.Settlements {
+		k.SetSettlement(ctx, settlement)
+	}
+	k.SetSettlementCount(ctx, genState.SettlementCount)
+
+	k.SetPort(ctx, genState.PortId)
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, genState.PortId) {
+		// module binds to the port on InitChain
+		// and claims the returned capability
+		if err := k.BindPort(ctx, genState.PortId); err != nil {
+			panic("could not claim port capability: " + err.Error())
+		}
+	}
+
+	// escrow accounts are derived from the channels, holders and ERC-20 facades
+	// from the bank balances, which are all initialized first
+	k.ResetTransferEscrows(ctx)
+	k.ResetHolders(ctx)
+	if err := k.ResetERC20Facades(ctx); err != nil {
+		panic("could not deploy the ERC-20 facades: " + err.Error())
+	}
+	if err := k.ResetAssetRegistry(ctx); err != nil {
+		panic("could not deploy the asset registry: " + err.Error())
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -21,6 +84,22 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 	genesis.Tokens = k.GetAllToken(ctx)
+	genesis.Redemptions = k.GetAllRedemptionRequest(ctx)
+	genesis.Roles = k.GetAllRole(ctx)
+	genesis.Frozen = k.GetAllFrozenAddress(ctx)
+	genesis.Authorizations = k.GetAllAuthorization(ctx)
+	genesis.Jurisdictions = k.GetAllAddressJurisdiction(ctx)
+	genesis.Denied = k.GetAllDeniedAddress(ctx)
+	genesis.PortId = k.GetPort(ctx)
+	genesis.Escrows = k.GetAllChannelEscrow(ctx)
+	genesis.Distributions = k.GetAllDistribution(ctx)
+	genesis.DistributionClaims = k.GetAllDistributionClaim(ctx)
+	genesis.SettledDistributionClaims = k.GetAllSettledDistributionClaim(ctx)
+	genesis.DistributionCount = k.GetDistributionCount(ctx)
+	genesis.Snapshots = k.GetAllSnapshot(ctx)
+	genesis.SnapshotBalances = k.GetAllSnapshotBalance(ctx)
+	genesis.Settlements = k.GetAllSettlement(ctx)
+	genesis.SettlementCount = k.GetSettlementCount(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
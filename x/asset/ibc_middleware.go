@@ -0,0 +1,202 @@
+package asset
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+)
+
+var (
+	_ porttypes.ICS4Wrapper = ICS4Wrapper{}
+	_ porttypes.Middleware  = IBCMiddleware{}
+)
+
+// ICS4Wrapper sits between the ICS-20 transfer keeper and core IBC and
+// rejects outbound transfers of asset tokens that may not leave the chain.
+// The escrow send that precedes it is a plain bank send, so the escrow
+// account alone is not enough to keep restricted tokens on the chain.
+type ICS4Wrapper struct {
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      keeper.Keeper
+}
+
+// NewICS4Wrapper creates the ICS4Wrapper to pass to the transfer keeper
+func NewICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, k keeper.Keeper) ICS4Wrapper {
+	return ICS4Wrapper{
+		ics4Wrapper: ics4Wrapper,
+		keeper:      k,
+	}
+}
+
+// SendPacket checks tokens sent from their source chain before passing the packet on
+func (w ICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err == nil &&
+		transfertypes.SenderChainIsSource(sourcePort, sourceChannel, packetData.Denom) {
+		if amount, ok := sdk.NewIntFromString(packetData.Amount); ok {
+			if err := w.keeper.CheckIBCSend(ctx, sdk.Coin{Denom: packetData.Denom, Amount: amount}); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return w.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+func (w ICS4Wrapper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	return w.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+func (w ICS4Wrapper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return w.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// IBCMiddleware wraps the ICS-20 transfer module and checks that asset tokens
+// returning to the chain can be released to their receiver. Outbound packets
+// are checked by the embedded ICS4Wrapper. The escrow accounts of the channels
+// are recorded as they open, so that the transfer checks can recognize them.
+type IBCMiddleware struct {
+	ICS4Wrapper
+	app porttypes.IBCModule
+}
+
+// NewIBCMiddleware creates the IBCMiddleware wrapping the transfer module app,
+// using the same ICS4Wrapper that was given to the transfer keeper
+func NewIBCMiddleware(app porttypes.IBCModule, ics4Wrapper ICS4Wrapper) IBCMiddleware {
+	return IBCMiddleware{
+		ICS4Wrapper: ics4Wrapper,
+		app:         app,
+	}
+}
+
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	version, err := im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, version)
+	if err != nil {
+		return "", err
+	}
+	im.keeper.SetTransferEscrow(ctx, channelID)
+	return version, nil
+}
+
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	version, err := im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
+	if err != nil {
+		return "", err
+	}
+	im.keeper.SetTransferEscrow(ctx, channelID)
+	return version, nil
+}
+
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket acknowledges with an error, so that the sender is refunded,
+// when an asset token returning from the other chain may not be released to
+// its receiver. Packets the transfer module cannot decode are left to it.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	// only tokens that left this chain are unescrowed, anything else is
+	// minted as a voucher
+	if !transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+	denom := transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	escrow := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+	if err := im.keeper.CheckIBCReceive(ctx, escrow, receiver, sdk.Coin{Denom: denom, Amount: amount}); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return im.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
@@ -0,0 +1,102 @@
+package asset_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/realiotech/realio-network/testutil"
+	"github.com/realiotech/realio-network/x/asset"
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// stubICS4Wrapper records the packets that reach core IBC
+type stubICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+	sent *int
+}
+
+func (w stubICS4Wrapper) SendPacket(sdk.Context, *capabilitytypes.Capability, string, string, clienttypes.Height, uint64, []byte) (uint64, error) {
+	*w.sent++
+	return uint64(*w.sent), nil
+}
+
+// stubTransferModule opens every channel and acknowledges every packet it receives
+type stubTransferModule struct {
+	porttypes.IBCModule
+}
+
+func (stubTransferModule) OnChanOpenInit(_ sdk.Context, _ channeltypes.Order, _ []string, _, _ string, _ *capabilitytypes.Capability, _ channeltypes.Counterparty, version string) (string, error) {
+	return version, nil
+}
+
+func (stubTransferModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func (suite *GenesisTestSuite) TestIBCMiddleware() {
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := testutil.GenAddress()
+	receiver := testutil.GenAddress()
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{
+		Manager: manager.String(), Name: "realio security token",
+		Symbol: "RST", Total: "1000", AuthorizationRequired: true,
+	})
+	suite.Require().NoError(err)
+
+	sent := 0
+	ics4Wrapper := asset.NewICS4Wrapper(stubICS4Wrapper{sent: &sent}, suite.app.AssetKeeper)
+	middleware := asset.NewIBCMiddleware(stubTransferModule{}, ics4Wrapper)
+
+	packetData := func(denom string) []byte {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "10", manager.String(), receiver.String(), "")
+		return data.GetBytes()
+	}
+
+	// outbound transfers of allowlist tokens need the ibc opt in
+	_, err = middleware.SendPacket(suite.ctx, nil, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0, packetData("arst"))
+	suite.Require().ErrorIs(err, types.ErrIBCTransferNotAllowed)
+	_, err = middleware.SendPacket(suite.ctx, nil, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0, packetData("ario"))
+	suite.Require().NoError(err)
+	_, err = srv.SetIBCEnabled(wctx, &types.MsgSetIBCEnabled{Manager: manager.String(), Symbol: "RST", Enabled: true})
+	suite.Require().NoError(err)
+	_, err = middleware.SendPacket(suite.ctx, nil, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0, packetData("arst"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, sent)
+
+	// the escrow send needs no authorization of the escrow account of the
+	// channel, recorded as it opens, which is not counted as a holder
+	_, err = middleware.OnChanOpenInit(
+		suite.ctx, channeltypes.UNORDERED, []string{"connection-0"}, transfertypes.PortID, "channel-0", nil,
+		channeltypes.NewCounterparty(transfertypes.PortID, ""), transfertypes.Version,
+	)
+	suite.Require().NoError(err)
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	other := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-2")
+	coins := sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(10)))
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, manager, other, coins)
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, manager, escrow, coins)
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", escrow))
+
+	// tokens returning from the other chain are checked against the receiver
+	packet := channeltypes.NewPacket(
+		packetData(transfertypes.GetPrefixedDenom(transfertypes.PortID, "channel-1", "arst")), 1,
+		transfertypes.PortID, "channel-1", transfertypes.PortID, "channel-0",
+		clienttypes.ZeroHeight(), 0,
+	)
+	ack := middleware.OnRecvPacket(suite.ctx, packet, nil)
+	suite.Require().False(ack.Success())
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager.String(), Symbol: "RST", Address: receiver.String()})
+	suite.Require().NoError(err)
+	ack = middleware.OnRecvPacket(suite.ctx, packet, nil)
+	suite.Require().True(ack.Success())
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// setAssetRegistryToken writes a token to the asset registry
+func (k Keeper) setAssetRegistryToken(ctx sdk.Context, token types.Token) {
+	// an invalid manager is written as the zero address
+	manager, _ := sdk.AccAddressFromBech32(token.Manager)
+	paused := big.NewInt(0)
+	if token.Paused {
+		paused = big.NewInt(1)
+	}
+
+	// in the order of the fields of the token
+	fields := []*big.Int{
+		new(big.Int).SetBytes(manager),
+		new(big.Int).SetBytes(types.ERC20Address(token.Symbol).Bytes()),
+		big.NewInt(int64(token.Decimals)),
+		paused,
+		big.NewInt(int64(token.EffectiveRestrictionMode())),
+	}
+	for field, value := range fields {
+		k.setContractWord(ctx, types.AssetRegistryAddress, types.AssetRegistryTokenSlot(token.Symbol, int64(field)), value)
+	}
+}
+
+// setAssetRegistryAuthorization writes the expiry of an authorization to the
+// asset registry, in seconds, authorizations without expiry never lapse
+func (k Keeper) setAssetRegistryAuthorization(ctx sdk.Context, authorization types.TokenAuthorization) {
+	address, err := sdk.AccAddressFromBech32(authorization.Address)
+	if err != nil {
+		return
+	}
+
+	expiry := gethmath.MaxBig256
+	if authorization.Expiry != nil {
+		expiry = big.NewInt(authorization.Expiry.Unix())
+		if expiry.Sign() < 0 {
+			expiry = big.NewInt(0)
+		}
+	}
+	slot := types.AssetRegistryAuthorizationSlot(authorization.Symbol, common.BytesToAddress(address))
+	k.setContractWord(ctx, types.AssetRegistryAddress, slot, expiry)
+}
+
+// removeAssetRegistryAuthorization removes an authorization from the asset registry
+func (k Keeper) removeAssetRegistryAuthorization(ctx sdk.Context, symbol string, address string) {
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return
+	}
+	slot := types.AssetRegistryAuthorizationSlot(symbol, common.BytesToAddress(accAddress))
+	k.setContractWord(ctx, types.AssetRegistryAddress, slot, big.NewInt(0))
+}
+
+// ResetAssetRegistry deploys the asset registry and copies all the tokens and
+// authorizations to it. It iterates over all authorizations and is only meant
+// for genesis and migrations.
+func (k Keeper) ResetAssetRegistry(ctx sdk.Context) error {
+	if err := k.deployCode(ctx, types.AssetRegistryAddress, types.AssetRegistryCode, types.AssetRegistryCodeHash); err != nil {
+		return err
+	}
+	for _, token := range k.GetAllToken(ctx) {
+		k.setAssetRegistryToken(ctx, token)
+	}
+	for _, authorization := range k.GetAllAuthorization(ctx) {
+		k.setAssetRegistryAuthorization(ctx, authorization)
+	}
+	return nil
+}
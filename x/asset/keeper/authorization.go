@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// SetAuthorization authorizes an address to hold a token in the store
+func (k Keeper) SetAuthorization(ctx sdk.Context, authorization types.TokenAuthorization) {
+	// a new authorization replaces the expiry of the previous one
+	k.removeAuthorizationExpiry(ctx, authorization.Symbol, authorization.Address)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationKeyPrefix))
+	lowerCased := strings.ToLower(authorization.Symbol)
+	authorization.Symbol = lowerCased
+	b := k.cdc.MustMarshal(&authorization)
+	store.Set(types.AuthorizationKey(
+		lowerCased,
+		authorization.Address,
+	), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AddressAuthorizationKeyPrefix))
+	indexStore.Set(types.AddressAuthorizationKey(
+		authorization.Address,
+		lowerCased,
+	), []byte{})
+
+	if authorization.Expiry != nil {
+		expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationExpiryKeyPrefix))
+		expiryStore.Set(types.AuthorizationExpiryKey(
+			*authorization.Expiry,
+			lowerCased,
+			authorization.Address,
+		), types.AuthorizationKey(lowerCased, authorization.Address))
+	}
+
+	k.setAssetRegistryAuthorization(ctx, authorization)
+}
+
+// RemoveAuthorization removes the authorization of an address for a token from the store
+func (k Keeper) RemoveAuthorization(ctx sdk.Context, symbol string, address string) {
+	k.removeAuthorizationExpiry(ctx, symbol, address)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	store.Delete(types.AuthorizationKey(
+		lowerCased,
+		address,
+	))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AddressAuthorizationKeyPrefix))
+	indexStore.Delete(types.AddressAuthorizationKey(
+		address,
+		lowerCased,
+	))
+
+	k.removeAssetRegistryAuthorization(ctx, symbol, address)
+}
+
+// GetAuthorization returns the authorization of an address for a token
+func (k Keeper) GetAuthorization(ctx sdk.Context, symbol string, address string) (val types.TokenAuthorization, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	b := store.Get(types.AuthorizationKey(
+		lowerCased,
+		address,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// IsAddressAuthorizedToSend returns whether the address is authorized for the token at the current block time
+func (k Keeper) IsAddressAuthorizedToSend(ctx sdk.Context, symbol string, address sdk.AccAddress) (authorized bool) {
+	authorization, found := k.GetAuthorization(ctx, symbol, address.String())
+	return found && !authorization.IsExpired(ctx.BlockTime())
+}
+
+// ExpireAuthorizations removes the authorizations that lapsed by the current block time,
+// at most MaxAuthorizationExpiriesPerBlock of them, and emits an authorization_expired
+// event for each of them. The ones left for the next blocks are already ignored by
+// IsAddressAuthorizedToSend.
+func (k Keeper) ExpireAuthorizations(ctx sdk.Context) {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationExpiryKeyPrefix))
+	// every key up to the current block time, the queue is ordered by expiry
+	end := sdk.PrefixEndBytes(append(sdk.FormatTimeBytes(ctx.BlockTime()), []byte("/")...))
+	iterator := expiryStore.Iterator(nil, end)
+
+	var expired []types.TokenAuthorization
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationKeyPrefix))
+	for ; iterator.Valid() && len(expired) < types.MaxAuthorizationExpiriesPerBlock; iterator.Next() {
+		var authorization types.TokenAuthorization
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &authorization)
+		expired = append(expired, authorization)
+	}
+	iterator.Close()
+
+	for _, authorization := range expired {
+		k.RemoveAuthorization(ctx, authorization.Symbol, authorization.Address)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAuthorizationExpired,
+				sdk.NewAttribute(types.AttributeKeySymbol, authorization.Symbol),
+				sdk.NewAttribute(types.AttributeKeyAddress, authorization.Address),
+			),
+		)
+	}
+}
+
+func (k Keeper) removeAuthorizationExpiry(ctx sdk.Context, symbol string, address string) {
+	existing, found := k.GetAuthorization(ctx, symbol, address)
+	if !found || existing.Expiry == nil {
+		return
+	}
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationExpiryKeyPrefix))
+	expiryStore.Delete(types.AuthorizationExpiryKey(
+		*existing.Expiry,
+		strings.ToLower(symbol),
+		address,
+	))
+}
+
+// GetAllAuthorization returns all token authorizations
+func (k Keeper) GetAllAuthorization(ctx sdk.Context) (list []types.TokenAuthorization) {
+	return k.getAuthorizations(ctx, []byte{})
+}
+
+// GetTokenAuthorizations returns all authorizations of a token
+func (k Keeper) GetTokenAuthorizations(ctx sdk.Context, symbol string) (list []types.TokenAuthorization) {
+	return k.getAuthorizations(ctx, types.TokenKey(strings.ToLower(symbol)))
+}
+
+func (k Keeper) getAuthorizations(ctx sdk.Context, keyPrefix []byte) (list []types.TokenAuthorization) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TokenAuthorization
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// SetDeniedAddress denies an address for a token in the store
+func (k Keeper) SetDeniedAddress(ctx sdk.Context, denied types.DeniedAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeniedKeyPrefix))
+	lowerCased := strings.ToLower(denied.Symbol)
+	b := k.cdc.MustMarshal(&denied)
+	store.Set(types.DeniedKey(
+		lowerCased,
+		denied.Address,
+	), b)
+}
+
+// RemoveDeniedAddress undenies an address for a token in the store
+func (k Keeper) RemoveDeniedAddress(ctx sdk.Context, symbol string, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeniedKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	store.Delete(types.DeniedKey(
+		lowerCased,
+		address,
+	))
+}
+
+// IsAddressDenied returns whether the address is denied for the token
+func (k Keeper) IsAddressDenied(ctx sdk.Context, symbol string, address sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeniedKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	return store.Has(types.DeniedKey(
+		lowerCased,
+		address.String(),
+	))
+}
+
+// GetAllDeniedAddress returns all denied addresses
+func (k Keeper) GetAllDeniedAddress(ctx sdk.Context) (list []types.DeniedAddress) {
+	return k.getDeniedAddresses(ctx, []byte{})
+}
+
+// GetTokenDeniedAddresses returns all denied addresses of a token
+func (k Keeper) GetTokenDeniedAddresses(ctx sdk.Context, symbol string) (list []types.DeniedAddress) {
+	return k.getDeniedAddresses(ctx, types.TokenKey(strings.ToLower(symbol)))
+}
+
+func (k Keeper) getDeniedAddresses(ctx sdk.Context, keyPrefix []byte) (list []types.DeniedAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeniedKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DeniedAddress
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
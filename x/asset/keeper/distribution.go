@@ -0,0 +1,641 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// GetDistributionCount returns the number of distributions created, which is the id of the next one
+func (k Keeper) GetDistributionCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(types.DistributionCountKey))
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetDistributionCount sets the number of distributions created
+func (k Keeper) SetDistributionCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.DistributionCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// SetDistribution set a specific distribution in the store from its id, and
+// queues it for the EndBlocker until it is allocated
+func (k Keeper) SetDistribution(ctx sdk.Context, distribution types.Distribution) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionKeyPrefix))
+	b := k.cdc.MustMarshal(&distribution)
+	store.Set(types.DistributionKey(distribution.Id), b)
+
+	allocationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionAllocationKeyPrefix))
+	if distribution.Stage == types.DistributionStageAllocated {
+		allocationStore.Delete(types.DistributionKey(distribution.Id))
+	} else {
+		allocationStore.Set(types.DistributionKey(distribution.Id), sdk.Uint64ToBigEndian(distribution.Id))
+	}
+}
+
+// removeDistribution removes a distribution from the store and the allocation queue
+func (k Keeper) removeDistribution(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionKeyPrefix))
+	store.Delete(types.DistributionKey(id))
+	allocationStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionAllocationKeyPrefix))
+	allocationStore.Delete(types.DistributionKey(id))
+}
+
+// GetDistribution returns a distribution from its id
+func (k Keeper) GetDistribution(ctx sdk.Context, id uint64) (val types.Distribution, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionKeyPrefix))
+	b := store.Get(types.DistributionKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDistribution returns all distributions being allocated or with pending claims
+func (k Keeper) GetAllDistribution(ctx sdk.Context) (list []types.Distribution) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Distribution
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetDistributionClaim set a specific distribution claim in the store, and
+// queues it for the EndBlocker unless it is held
+func (k Keeper) SetDistributionClaim(ctx sdk.Context, claim types.DistributionClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionClaimKeyPrefix))
+	b := k.cdc.MustMarshal(&claim)
+	store.Set(types.DistributionClaimKey(claim.Holder, claim.DistributionId), b)
+
+	payoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionPayoutKeyPrefix))
+	payoutKey := types.DistributionPayoutKey(claim.DistributionId, claim.Holder)
+	if claim.Held {
+		payoutStore.Delete(payoutKey)
+	} else {
+		payoutStore.Set(payoutKey, []byte(claim.Holder))
+	}
+}
+
+// GetDistributionClaim returns the claim of a holder on a distribution
+func (k Keeper) GetDistributionClaim(ctx sdk.Context, holder string, id uint64) (val types.DistributionClaim, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionClaimKeyPrefix))
+	b := store.Get(types.DistributionClaimKey(holder, id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDistributionClaim returns all pending distribution claims
+func (k Keeper) GetAllDistributionClaim(ctx sdk.Context) (list []types.DistributionClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionClaimKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DistributionClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetSettledDistributionClaim marks a claim as settled, so that the share is
+// not allocated again while its distribution is allocated
+func (k Keeper) SetSettledDistributionClaim(ctx sdk.Context, claim types.DistributionClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionSettledKeyPrefix))
+	b := k.cdc.MustMarshal(&claim)
+	store.Set(types.DistributionPayoutKey(claim.DistributionId, claim.Holder), b)
+}
+
+// hasSettledDistributionClaim checks if the share of a holder on a distribution was settled
+func (k Keeper) hasSettledDistributionClaim(ctx sdk.Context, holder string, id uint64) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionSettledKeyPrefix))
+	return store.Has(types.DistributionPayoutKey(id, holder))
+}
+
+// GetAllSettledDistributionClaim returns the claims settled while their distribution is allocated
+func (k Keeper) GetAllSettledDistributionClaim(ctx sdk.Context) (list []types.DistributionClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionSettledKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DistributionClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// removeDistributionClaim removes a settled claim from the store and the
+// payout queue, and removes its distribution once it is allocated and no claim
+// is pending anymore
+func (k Keeper) removeDistributionClaim(ctx sdk.Context, distribution types.Distribution, claim types.DistributionClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionClaimKeyPrefix))
+	store.Delete(types.DistributionClaimKey(claim.Holder, claim.DistributionId))
+	payoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionPayoutKeyPrefix))
+	payoutStore.Delete(types.DistributionPayoutKey(claim.DistributionId, claim.Holder))
+	if isAllocating(distribution) {
+		k.SetSettledDistributionClaim(ctx, claim)
+	}
+
+	distribution.PendingClaims--
+	if distribution.PendingClaims == 0 && distribution.Stage == types.DistributionStageAllocated {
+		k.removeDistribution(ctx, distribution.Id)
+		return
+	}
+	k.SetDistribution(ctx, distribution)
+}
+
+// distribute escrows amount from the distributor and takes a snapshot of the
+// token. The EndBlocker then allocates the shares of the holders, but the
+// distributor, pro rata to their balance in the snapshot.
+func (k Keeper) distribute(ctx sdk.Context, token types.Token, distributor sdk.AccAddress, amount sdk.Coin, policy types.DistributionPolicy) (types.Distribution, error) {
+	holders := k.GetHolderCount(ctx, token.Symbol)
+	if k.IsHolder(ctx, token.Symbol, distributor) {
+		holders--
+	}
+	if holders == 0 {
+		return types.Distribution{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s has no holders to distribute to", token.Symbol)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, distributor, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return types.Distribution{}, err
+	}
+
+	id := k.GetDistributionCount(ctx)
+	k.SetDistributionCount(ctx, id+1)
+	snapshot := k.createSnapshot(ctx, token)
+
+	distribution := types.Distribution{
+		Id:          id,
+		Symbol:      token.Symbol,
+		Distributor: distributor.String(),
+		Denom:       amount.Denom,
+		Amount:      amount.Amount.String(),
+		Height:      ctx.BlockHeight(),
+		Policy:      policy,
+		SnapshotId:  snapshot.Id,
+		Allocated:   math.ZeroInt().String(),
+		Stage:       types.DistributionStageHolders,
+	}
+	k.SetDistribution(ctx, distribution)
+
+	// the supply is only known once the balances of the snapshot are final
+	store := prefix.NewStore(ctx.TransientStore(k.tkey), types.KeyPrefix(types.DistributionCreatedKeyPrefix))
+	store.Set(types.DistributionKey(id), sdk.Uint64ToBigEndian(id))
+
+	return distribution, nil
+}
+
+// isDistributionHolder returns whether an address shares a distribution:
+// module accounts, ICS-20 escrow accounts and the distributor do not
+func (k Keeper) isDistributionHolder(ctx sdk.Context, distribution types.Distribution, address sdk.AccAddress) bool {
+	return address.String() != distribution.Distributor && !k.AllowAddr(address) && !k.IsTransferEscrow(ctx, address)
+}
+
+// distributionSupply returns the sum of the balances sharing a distribution,
+// the supply of the token but for the balances of the addresses that are not
+// distribution holders
+func (k Keeper) distributionSupply(ctx sdk.Context, token types.Token, distribution types.Distribution) math.Int {
+	denom := tokenDenom(token)
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+
+	excluded := make(map[string]bool)
+	excluded[distribution.Distributor] = true
+	for address := range k.allowAddrs {
+		excluded[address] = true
+	}
+	for _, escrow := range k.transferEscrows(ctx) {
+		excluded[escrow.String()] = true
+	}
+	for address := range excluded {
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			continue
+		}
+		supply = supply.Sub(k.bankKeeper.GetBalance(ctx, accAddress, denom).Amount)
+	}
+	return supply
+}
+
+// distributionShare returns the claim of an address on a distribution, from
+// its balance in the snapshot. It returns false when the address does not share
+// the distribution, or was already allocated its share.
+func (k Keeper) distributionShare(ctx sdk.Context, distribution types.Distribution, token types.Token, address sdk.AccAddress) (types.DistributionClaim, bool) {
+	holder := address.String()
+	if !k.isDistributionHolder(ctx, distribution, address) {
+		return types.DistributionClaim{}, false
+	}
+	if _, found := k.GetDistributionClaim(ctx, holder, distribution.Id); found || k.hasSettledDistributionClaim(ctx, holder, distribution.Id) {
+		return types.DistributionClaim{}, false
+	}
+	balance, err := k.GetBalanceAt(ctx, token, address, distribution.SnapshotId)
+	if err != nil || !balance.IsPositive() {
+		return types.DistributionClaim{}, false
+	}
+
+	amount, _ := math.NewIntFromString(distribution.Amount)
+	supply, _ := math.NewIntFromString(distribution.Supply)
+	allocated, _ := math.NewIntFromString(distribution.Allocated)
+	// a holder left out of the supply, holding since before holders were
+	// tracked, cannot be paid out of the shares of the others
+	share := math.MinInt(amount.Mul(balance).Quo(supply), amount.Sub(allocated))
+	if !share.IsPositive() {
+		return types.DistributionClaim{}, false
+	}
+	return types.DistributionClaim{
+		DistributionId: distribution.Id,
+		Holder:         holder,
+		Balance:        balance.String(),
+		Amount:         share.String(),
+	}, true
+}
+
+// getAllocatingDistributions returns the distributions whose shares are being allocated
+func (k Keeper) getAllocatingDistributions(ctx sdk.Context) (list []types.Distribution) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionAllocationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		distribution, found := k.GetDistribution(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if found && isAllocating(distribution) {
+			list = append(list, distribution)
+		}
+	}
+
+	return
+}
+
+// isAllocating returns whether the shares of a distribution are being allocated
+func isAllocating(distribution types.Distribution) bool {
+	return distribution.Supply != "" &&
+		(distribution.Stage == types.DistributionStageHolders || distribution.Stage == types.DistributionStageMoved)
+}
+
+// allocateDistributionClaim allocates its share of a distribution to an
+// address and queues the claim for payout
+func (k Keeper) allocateDistributionClaim(ctx sdk.Context, distribution *types.Distribution, token types.Token, address sdk.AccAddress) (types.DistributionClaim, bool) {
+	claim, found := k.distributionShare(ctx, *distribution, token, address)
+	if !found {
+		return claim, false
+	}
+	k.SetDistributionClaim(ctx, claim)
+
+	allocated, _ := math.NewIntFromString(distribution.Allocated)
+	share, _ := math.NewIntFromString(claim.Amount)
+	distribution.Allocated = allocated.Add(share).String()
+	distribution.PendingClaims++
+	return claim, true
+}
+
+// refundDistribution returns an amount of a distribution to the distributor
+func (k Keeper) refundDistribution(ctx sdk.Context, distribution types.Distribution, amount math.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+	distributor, err := sdk.AccAddressFromBech32(distribution.Distributor)
+	if err != nil {
+		k.Logger(ctx).Error("invalid distributor", "distribution", distribution.Id, "error", err)
+		return
+	}
+	cacheCtx, write := ctx.CacheContext()
+	coins := sdk.NewCoins(sdk.NewCoin(distribution.Denom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, distributor, coins); err != nil {
+		k.Logger(ctx).Error("failed to refund distribution", "distribution", distribution.Id, "error", err)
+		return
+	}
+	write()
+}
+
+// fixDistributionSupplies sets the supply of the distributions created during
+// the block from the balances at its end, the balances of their snapshot. A
+// distribution that nobody shares anymore is refunded.
+func (k Keeper) fixDistributionSupplies(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.TransientStore(k.tkey), types.KeyPrefix(types.DistributionCreatedKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		distribution, found := k.GetDistribution(ctx, sdk.BigEndianToUint64(key[:8]))
+		if !found {
+			continue
+		}
+		token, found := k.GetToken(ctx, distribution.Symbol)
+		if !found {
+			k.Logger(ctx).Error("token of a distribution not found", "distribution", distribution.Id, "symbol", distribution.Symbol)
+			continue
+		}
+
+		supply := k.distributionSupply(ctx, token, distribution)
+		if !supply.IsPositive() {
+			amount, _ := math.NewIntFromString(distribution.Amount)
+			k.refundDistribution(ctx, distribution, amount)
+			k.removeDistribution(ctx, distribution.Id)
+			continue
+		}
+		distribution.Supply = supply.String()
+		k.SetDistribution(ctx, distribution)
+	}
+}
+
+// nextKeys returns up to limit keys of store from start, all of them when limit
+// is negative, and the key to start from next, nil once the store is exhausted
+func nextKeys(store prefix.Store, start []byte, limit int) (keys [][]byte, next []byte) {
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		if len(keys) == limit {
+			return keys, key
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// AllocateDistributions fixes the supply of the distributions created during
+// the block, then allocates the shares of the queued distributions, visiting
+// at most MaxDistributionAllocationsPerBlock store entries. The claims are
+// queued for the payouts of the following blocks.
+func (k Keeper) AllocateDistributions(ctx sdk.Context) {
+	k.fixDistributionSupplies(ctx)
+
+	budget := types.MaxDistributionAllocationsPerBlock
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionAllocationKeyPrefix))
+	keys, _ := nextKeys(store, nil, budget)
+	for _, key := range keys {
+		if budget == 0 {
+			break
+		}
+		distribution, found := k.GetDistribution(ctx, sdk.BigEndianToUint64(key[:8]))
+		if !found {
+			k.Logger(ctx).Error("queued distribution not found", "distribution", sdk.BigEndianToUint64(key[:8]))
+			store.Delete(key)
+			budget--
+			continue
+		}
+		budget = k.allocateDistribution(ctx, distribution, budget)
+	}
+}
+
+// allocateDistribution carries on the allocation of a distribution for up to
+// budget store entries, and returns the budget left. The current holders of
+// the token are allocated first, then the addresses whose balance moved since
+// the snapshot, which may have left the holders. Once every share is
+// allocated, the rounding remainder returns to the distributor and the markers
+// of the settled shares are cleared.
+func (k Keeper) allocateDistribution(ctx sdk.Context, distribution types.Distribution, budget int) int {
+	// the supply is fixed at the end of the block the distribution is created in
+	if distribution.Supply == "" {
+		return budget
+	}
+	token, found := k.GetToken(ctx, distribution.Symbol)
+	if !found {
+		k.Logger(ctx).Error("token of a distribution not found", "distribution", distribution.Id, "symbol", distribution.Symbol)
+		return budget - 1
+	}
+
+	lowerCased := strings.ToLower(distribution.Symbol)
+	for budget > 0 && distribution.Stage != types.DistributionStageAllocated {
+		var keys [][]byte
+		var next []byte
+		switch distribution.Stage {
+		case types.DistributionStageHolders:
+			// the keys are address/
+			store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.HolderKeyPrefix), types.TokenKey(lowerCased)...))
+			keys, next = nextKeys(store, distribution.Cursor, budget)
+			for _, key := range keys {
+				address, err := sdk.AccAddressFromBech32(strings.TrimSuffix(string(key), "/"))
+				if err == nil {
+					k.allocateDistributionClaim(ctx, &distribution, token, address)
+				}
+			}
+			if next == nil {
+				distribution.Stage = types.DistributionStageMoved
+			}
+
+		case types.DistributionStageMoved:
+			// the keys are address/ followed by the snapshot id and a separator
+			store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.SnapshotBalanceKeyPrefix), types.TokenKey(lowerCased)...))
+			keys, next = nextKeys(store, distribution.Cursor, budget)
+			for _, key := range keys {
+				if len(key) < 10 || sdk.BigEndianToUint64(key[len(key)-9:len(key)-1]) < distribution.SnapshotId {
+					continue
+				}
+				address, err := sdk.AccAddressFromBech32(string(key[:len(key)-10]))
+				if err == nil {
+					k.allocateDistributionClaim(ctx, &distribution, token, address)
+				}
+			}
+			if next == nil {
+				// the balances of the addresses that first moved during the
+				// block are only recorded at its end
+				deltaStore := prefix.NewStore(ctx.TransientStore(k.tkey), append(types.KeyPrefix(types.SnapshotDeltaKeyPrefix), types.TokenKey(lowerCased)...))
+				deltas, _ := nextKeys(deltaStore, nil, -1)
+				for _, key := range deltas {
+					address, err := sdk.AccAddressFromBech32(strings.TrimSuffix(string(key), "/"))
+					if err == nil {
+						k.allocateDistributionClaim(ctx, &distribution, token, address)
+					}
+				}
+
+				amount, _ := math.NewIntFromString(distribution.Amount)
+				allocated, _ := math.NewIntFromString(distribution.Allocated)
+				k.refundDistribution(ctx, distribution, amount.Sub(allocated))
+				distribution.Stage = types.DistributionStagePruning
+			}
+
+		case types.DistributionStagePruning:
+			store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.DistributionSettledKeyPrefix), types.DistributionKey(distribution.Id)...))
+			keys, next = nextKeys(store, nil, budget)
+			for _, key := range keys {
+				store.Delete(key)
+			}
+			if next == nil {
+				distribution.Stage = types.DistributionStageAllocated
+			}
+		}
+		budget -= len(keys)
+		distribution.Cursor = next
+	}
+
+	if distribution.Stage == types.DistributionStageAllocated && distribution.PendingClaims == 0 {
+		k.removeDistribution(ctx, distribution.Id)
+		return budget
+	}
+	k.SetDistribution(ctx, distribution)
+	return budget
+}
+
+// isEligibleHolder returns whether a holder can receive a distribution of the
+// token: it must not be frozen, and must be allowed by the restriction mode
+func (k Keeper) isEligibleHolder(ctx sdk.Context, token types.Token, holder sdk.AccAddress) bool {
+	if k.IsAddressFrozen(ctx, token.Symbol, holder) {
+		return false
+	}
+	switch token.EffectiveRestrictionMode() {
+	case types.RestrictionModeAllowlist:
+		return k.IsAddressAuthorizedToSend(ctx, token.Symbol, holder)
+	case types.RestrictionModeDenylist:
+		return !k.IsAddressDenied(ctx, token.Symbol, holder)
+	}
+	return true
+}
+
+// payDistributionClaim sends the share of the claim to its holder
+func (k Keeper) payDistributionClaim(ctx sdk.Context, distribution types.Distribution, claim types.DistributionClaim) error {
+	holder, err := sdk.AccAddressFromBech32(claim.Holder)
+	if err != nil {
+		return err
+	}
+	if err := k.sendDistributionShare(ctx, distribution, claim, holder); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributionPaid,
+			sdk.NewAttribute(types.AttributeKeyDistribution, strconv.FormatUint(distribution.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySymbol, distribution.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, claim.Holder),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claim.Amount+distribution.Denom),
+		),
+	)
+	return nil
+}
+
+// forfeitDistributionClaim returns the share of the claim to the distributor
+func (k Keeper) forfeitDistributionClaim(ctx sdk.Context, distribution types.Distribution, claim types.DistributionClaim) error {
+	distributor, err := sdk.AccAddressFromBech32(distribution.Distributor)
+	if err != nil {
+		return err
+	}
+	if err := k.sendDistributionShare(ctx, distribution, claim, distributor); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributionForfeit,
+			sdk.NewAttribute(types.AttributeKeyDistribution, strconv.FormatUint(distribution.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySymbol, distribution.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, claim.Holder),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claim.Amount+distribution.Denom),
+		),
+	)
+	return nil
+}
+
+// sendDistributionShare sends the share of the claim out of the module and settles the claim
+func (k Keeper) sendDistributionShare(ctx sdk.Context, distribution types.Distribution, claim types.DistributionClaim, to sdk.AccAddress) error {
+	amount, _ := math.NewIntFromString(claim.Amount)
+	coins := sdk.NewCoins(sdk.NewCoin(distribution.Denom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins); err != nil {
+		return err
+	}
+	k.removeDistributionClaim(ctx, distribution, claim)
+	return nil
+}
+
+// PayoutDistributions pays out up to MaxDistributionPayoutsPerBlock queued
+// claims. The share of a holder that is not eligible, or cannot receive it, is
+// held or forfeited according to the policy of the distribution. Claims that
+// cannot be settled are logged and taken out of the queue.
+func (k Keeper) PayoutDistributions(ctx sdk.Context) {
+	payoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionPayoutKeyPrefix))
+	keys, _ := nextKeys(payoutStore, nil, types.MaxDistributionPayoutsPerBlock)
+
+	for _, key := range keys {
+		id := sdk.BigEndianToUint64(key[:8])
+		bech32 := strings.TrimSuffix(string(key[9:]), "/")
+		claim, found := k.GetDistributionClaim(ctx, bech32, id)
+		if !found {
+			k.Logger(ctx).Error("queued distribution claim not found", "distribution", id, "holder", bech32)
+			payoutStore.Delete(key)
+			continue
+		}
+		distribution, found := k.GetDistribution(ctx, id)
+		if !found {
+			k.Logger(ctx).Error("distribution of a queued claim not found", "distribution", id, "holder", bech32)
+			claim.Held = true
+			k.SetDistributionClaim(ctx, claim)
+			continue
+		}
+		holder, err := sdk.AccAddressFromBech32(claim.Holder)
+		if err != nil {
+			k.Logger(ctx).Error("invalid holder of a queued claim", "distribution", id, "holder", bech32, "error", err)
+			claim.Held = true
+			k.SetDistributionClaim(ctx, claim)
+			continue
+		}
+		token, found := k.GetToken(ctx, distribution.Symbol)
+
+		if found && k.isEligibleHolder(ctx, token, holder) {
+			cacheCtx, write := ctx.CacheContext()
+			err := k.payDistributionClaim(cacheCtx, distribution, claim)
+			if err == nil {
+				write()
+				continue
+			}
+			k.Logger(ctx).Error("failed to pay distribution claim", "distribution", id, "holder", bech32, "error", err)
+		}
+
+		if distribution.Policy == types.DistributionPolicyForfeit {
+			cacheCtx, write := ctx.CacheContext()
+			err := k.forfeitDistributionClaim(cacheCtx, distribution, claim)
+			if err == nil {
+				write()
+				continue
+			}
+			k.Logger(ctx).Error("failed to forfeit distribution claim", "distribution", id, "holder", bech32, "error", err)
+		}
+
+		claim.Held = true
+		k.SetDistributionClaim(ctx, claim)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributionHeld,
+				sdk.NewAttribute(types.AttributeKeyDistribution, strconv.FormatUint(distribution.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeySymbol, distribution.Symbol),
+				sdk.NewAttribute(types.AttributeKeyAddress, claim.Holder),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, claim.Amount+distribution.Denom),
+			),
+		)
+	}
+}
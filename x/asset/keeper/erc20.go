@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// GetERC20Symbol returns the symbol of the token served by an ERC-20 facade
+func (k Keeper) GetERC20Symbol(ctx sdk.Context, address common.Address) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ERC20KeyPrefix))
+	b := store.Get(types.ERC20Key(address.Hex()))
+	if b == nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+// deployERC20Facade deploys the ERC-20 facade of a token on the EVM and
+// writes its metadata and supply. Balances are filled in as the token moves.
+func (k Keeper) deployERC20Facade(ctx sdk.Context, token types.Token) error {
+	address := types.ERC20Address(token.Symbol)
+	if err := k.deployCode(ctx, address, types.ERC20FacadeCode, types.ERC20FacadeCodeHash); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ERC20KeyPrefix))
+	store.Set(types.ERC20Key(address.Hex()), []byte(token.Symbol))
+
+	k.setERC20Metadata(ctx, token)
+	k.syncERC20Supply(ctx, token)
+	return nil
+}
+
+// deployCode sets the code of a contract deployed by the module at address
+func (k Keeper) deployCode(ctx sdk.Context, address common.Address, code []byte, codeHash common.Hash) error {
+	accAddress := sdk.AccAddress(address.Bytes())
+	account := k.ak.GetAccount(ctx, accAddress)
+	if account == nil {
+		account = k.ak.NewAccountWithAddress(ctx, accAddress)
+	}
+	ethAccount, ok := account.(ethermint.EthAccountI)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s is not an ethereum account", accAddress)
+	}
+	if err := ethAccount.SetCodeHash(codeHash); err != nil {
+		return err
+	}
+	k.ak.SetAccount(ctx, ethAccount)
+	k.evmKeeper.SetCode(ctx, codeHash.Bytes(), code)
+	return nil
+}
+
+// setERC20Metadata writes the name, symbol and decimals of a token to its
+// ERC-20 facade
+func (k Keeper) setERC20Metadata(ctx sdk.Context, token types.Token) {
+	address := types.ERC20Address(token.Symbol)
+	for slot, value := range types.ERC20StringSlots(types.ERC20NameSlot, token.Name) {
+		k.evmKeeper.SetState(ctx, address, slot, value.Bytes())
+	}
+	for slot, value := range types.ERC20StringSlots(types.ERC20SymbolSlot, token.Symbol) {
+		k.evmKeeper.SetState(ctx, address, slot, value.Bytes())
+	}
+	k.setContractWord(ctx, address, types.ERC20DecimalsSlot, big.NewInt(int64(token.Decimals)))
+}
+
+// setContractWord writes a number to the storage of a contract deployed by
+// the module, zero clears the slot
+func (k Keeper) setContractWord(ctx sdk.Context, address common.Address, slot common.Hash, value *big.Int) {
+	if value.Sign() == 0 {
+		k.evmKeeper.SetState(ctx, address, slot, nil)
+		return
+	}
+	k.evmKeeper.SetState(ctx, address, slot, common.BigToHash(value).Bytes())
+}
+
+// setERC20Balance writes the balance of an address to the ERC-20 facade of the token
+func (k Keeper) setERC20Balance(ctx sdk.Context, token types.Token, address sdk.AccAddress, balance math.Int) {
+	facade := types.ERC20Address(token.Symbol)
+	k.setContractWord(ctx, facade, types.ERC20BalanceSlot(common.BytesToAddress(address)), balance.BigInt())
+}
+
+// syncERC20Transfer writes to the ERC-20 facade of the token the balances a
+// send of coin leaves the sides with. The send restriction calls it before the
+// bank moves the coin, while InputOutputCoins has already debited the sender.
+func (k Keeper) syncERC20Transfer(ctx sdk.Context, token types.Token, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin) {
+	fromBalance := k.bankKeeper.GetBalance(ctx, fromAddr, coin.Denom).Amount
+	if fromAddr.Equals(toAddr) {
+		if hasInputDebited(ctx) {
+			k.setERC20Balance(ctx, token, fromAddr, fromBalance.Add(coin.Amount))
+		}
+		return
+	}
+
+	if !hasInputDebited(ctx) {
+		// the bank rejects the send when the balance is short
+		if fromBalance.LT(coin.Amount) {
+			return
+		}
+		fromBalance = fromBalance.Sub(coin.Amount)
+	}
+	k.setERC20Balance(ctx, token, fromAddr, fromBalance)
+	k.setERC20Balance(ctx, token, toAddr, k.bankKeeper.GetBalance(ctx, toAddr, coin.Denom).Amount.Add(coin.Amount))
+}
+
+// syncERC20Supply writes the total supply of the token to its ERC-20 facade,
+// along with the balance of the module account, which mints and burns it
+// outside of the send restriction
+func (k Keeper) syncERC20Supply(ctx sdk.Context, token types.Token) {
+	denom := tokenDenom(token)
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	k.setContractWord(ctx, types.ERC20Address(token.Symbol), types.ERC20TotalSupplySlot, supply.Amount.BigInt())
+
+	moduleAddress := k.ak.GetModuleAddress(types.ModuleName)
+	k.setERC20Balance(ctx, token, moduleAddress, k.bankKeeper.GetBalance(ctx, moduleAddress, denom).Amount)
+}
+
+// ResetERC20Facades deploys the ERC-20 facade of every token and copies all
+// the balances and supplies from the bank. It iterates over all balances and
+// is only meant for genesis and migrations.
+func (k Keeper) ResetERC20Facades(ctx sdk.Context) error {
+	denoms := make(map[string]types.Token)
+	for _, token := range k.GetAllToken(ctx) {
+		if err := k.deployERC20Facade(ctx, token); err != nil {
+			return err
+		}
+		denoms[tokenDenom(token)] = token
+	}
+
+	k.bankKeeper.IterateAllBalances(ctx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		token, found := denoms[coin.Denom]
+		if found {
+			facade := types.ERC20Address(token.Symbol)
+			k.setContractWord(ctx, facade, types.ERC20BalanceSlot(common.BytesToAddress(address)), coin.Amount.BigInt())
+		}
+		return false
+	})
+	return nil
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// SetChannelEscrow sets the amount of a token escrowed on an asset channel in the store
+func (k Keeper) SetChannelEscrow(ctx sdk.Context, escrow types.ChannelEscrow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EscrowKeyPrefix))
+	lowerCased := strings.ToLower(escrow.Symbol)
+	escrow.Symbol = lowerCased
+	b := k.cdc.MustMarshal(&escrow)
+	store.Set(types.EscrowKey(
+		escrow.Channel,
+		lowerCased,
+	), b)
+}
+
+// GetChannelEscrow returns the amount of a token, in base units, escrowed on an asset channel
+func (k Keeper) GetChannelEscrow(ctx sdk.Context, channel string, symbol string) math.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EscrowKeyPrefix))
+	b := store.Get(types.EscrowKey(
+		channel,
+		strings.ToLower(symbol),
+	))
+	if b == nil {
+		return math.ZeroInt()
+	}
+
+	var val types.ChannelEscrow
+	k.cdc.MustUnmarshal(b, &val)
+	amount, _ := math.NewIntFromString(val.Amount)
+	return amount
+}
+
+// GetAllChannelEscrow returns the escrowed amounts of all tokens on all asset channels
+func (k Keeper) GetAllChannelEscrow(ctx sdk.Context) (list []types.ChannelEscrow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EscrowKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChannelEscrow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// addChannelEscrow records amount base units of a token leaving over an asset channel
+func (k Keeper) addChannelEscrow(ctx sdk.Context, channel string, symbol string, amount math.Int) {
+	escrowed := k.GetChannelEscrow(ctx, channel, symbol).Add(amount)
+	k.SetChannelEscrow(ctx, types.NewChannelEscrow(channel, symbol, escrowed.String()))
+}
+
+// subChannelEscrow records amount base units of a token coming back over an
+// asset channel. A channel can never release more than was sent over it.
+func (k Keeper) subChannelEscrow(ctx sdk.Context, channel string, symbol string, amount math.Int) error {
+	escrowed := k.GetChannelEscrow(ctx, channel, symbol)
+	if escrowed.LT(amount) {
+		return sdkerrors.Wrapf(types.ErrInsufficientEscrow, "%s has %s%s escrowed", channel, escrowed, symbol)
+	}
+	k.SetChannelEscrow(ctx, types.NewChannelEscrow(channel, symbol, escrowed.Sub(amount).String()))
+	return nil
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+var _ evmtypes.EvmHooks = EVMHooks{}
+
+// EVMHooks settles the transfers made through the ERC-20 facades and the
+// authorizations made through the asset registry
+type EVMHooks struct {
+	k Keeper
+}
+
+// EVMHooks returns the EVM hooks of the keeper
+func (k Keeper) EVMHooks() EVMHooks {
+	return EVMHooks{k}
+}
+
+// PostTxProcessing moves the bank balances for the Transfer logs of the
+// ERC-20 facades. The sends go through AssetSendRestriction like any other
+// bank send, a rejected send reverts the whole EVM transaction. The logs of
+// the asset registry are applied in the same order.
+func (h EVMHooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	transferEvent := types.ERC20ABI.Events["Transfer"]
+	for _, log := range receipt.Logs {
+		if log.Address == types.AssetRegistryAddress {
+			if err := h.applyAssetRegistryLog(ctx, log); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(log.Topics) != 3 || log.Topics[0] != transferEvent.ID {
+			continue
+		}
+		symbol, found := h.k.GetERC20Symbol(ctx, log.Address)
+		if !found {
+			continue
+		}
+		token, found := h.k.GetToken(ctx, symbol)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", symbol)
+		}
+
+		from := sdk.AccAddress(log.Topics[1].Bytes()[12:])
+		to := sdk.AccAddress(log.Topics[2].Bytes()[12:])
+		amount := math.NewIntFromBigInt(new(big.Int).SetBytes(log.Data))
+		coins := sdk.NewCoins(sdk.NewCoin(tokenDenom(token), amount))
+		if err := h.k.bankKeeper.SendCoins(ctx, from, to, coins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyAssetRegistryLog authorizes or unauthorizes an address for the
+// Authorize and Unauthorize logs of the asset registry. The log is handled as
+// the message of its caller, which has to be allowed to send it like any
+// other signer, a rejected message reverts the whole EVM transaction.
+func (h EVMHooks) applyAssetRegistryLog(ctx sdk.Context, log *ethtypes.Log) error {
+	if len(log.Topics) != 3 {
+		return nil
+	}
+	event, err := types.AssetRegistryABI.EventByID(log.Topics[0])
+	if err != nil {
+		return nil
+	}
+	args, err := event.Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return err
+	}
+
+	symbol, _ := args[0].(string)
+	caller := sdk.AccAddress(log.Topics[1].Bytes()[12:]).String()
+	account := sdk.AccAddress(log.Topics[2].Bytes()[12:]).String()
+	srv := NewMsgServerImpl(h.k)
+	switch event.Name {
+	case "Authorize":
+		msg := types.NewMsgAuthorizeAddress(caller, symbol, account)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err = srv.AuthorizeAddress(sdk.WrapSDKContext(ctx), msg)
+	case "Unauthorize":
+		msg := types.NewMsgUnAuthorizeAddress(caller, symbol, account)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err = srv.UnAuthorizeAddress(sdk.WrapSDKContext(ctx), msg)
+	}
+	return err
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// SetFrozenAddress freezes an address for a token in the store
+func (k Keeper) SetFrozenAddress(ctx sdk.Context, frozen types.FrozenAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FrozenKeyPrefix))
+	lowerCased := strings.ToLower(frozen.Symbol)
+	b := k.cdc.MustMarshal(&frozen)
+	store.Set(types.FrozenKey(
+		lowerCased,
+		frozen.Address,
+	), b)
+}
+
+// RemoveFrozenAddress unfreezes an address for a token in the store
+func (k Keeper) RemoveFrozenAddress(ctx sdk.Context, symbol string, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FrozenKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	store.Delete(types.FrozenKey(
+		lowerCased,
+		address,
+	))
+}
+
+// IsAddressFrozen returns whether the address is frozen for the token
+func (k Keeper) IsAddressFrozen(ctx sdk.Context, symbol string, address sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FrozenKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	return store.Has(types.FrozenKey(
+		lowerCased,
+		address.String(),
+	))
+}
+
+// GetAllFrozenAddress returns all frozen addresses
+func (k Keeper) GetAllFrozenAddress(ctx sdk.Context) (list []types.FrozenAddress) {
+	return k.getFrozenAddresses(ctx, []byte{})
+}
+
+// GetTokenFrozenAddresses returns all frozen addresses of a token
+func (k Keeper) GetTokenFrozenAddresses(ctx sdk.Context, symbol string) (list []types.FrozenAddress) {
+	return k.getFrozenAddresses(ctx, types.TokenKey(strings.ToLower(symbol)))
+}
+
+func (k Keeper) getFrozenAddresses(ctx sdk.Context, keyPrefix []byte) (list []types.FrozenAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FrozenKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FrozenAddress
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
@@ -29,7 +33,43 @@ func (k Keeper) Tokens(c context.Context, req *types.QueryTokensRequest) (*types
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	return &types.QueryTokensResponse{Tokens: k.GetAllToken(ctx)}, nil
+	var authorizationRequired *bool
+	if req.AuthorizationRequired != "" {
+		required, err := strconv.ParseBool(req.AuthorizationRequired)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid authorizationRequired filter %s", req.AuthorizationRequired)
+		}
+		authorizationRequired = &required
+	}
+
+	// token keys start with the lowercased symbol, so a symbol prefix narrows the store itself
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenKeyPrefix))
+	store = prefix.NewStore(store, []byte(strings.ToLower(req.SymbolPrefix)))
+
+	var tokens []types.Token
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var token types.Token
+		if err := k.cdc.Unmarshal(value, &token); err != nil {
+			return false, err
+		}
+
+		if req.Manager != "" && token.Manager != req.Manager {
+			return false, nil
+		}
+		if authorizationRequired != nil && token.AuthorizationRequired != *authorizationRequired {
+			return false, nil
+		}
+
+		if accumulate {
+			tokens = append(tokens, token)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokensResponse{Tokens: tokens, Pagination: pageRes}, nil
 }
 
 func (k Keeper) Token(c context.Context, req *types.QueryTokenRequest) (*types.QueryTokenResponse, error) {
@@ -51,11 +91,280 @@ func (k Keeper) IsAuthorized(c context.Context, req *types.QueryIsAuthorizedRequ
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	if t, found := k.GetToken(ctx, req.Symbol); !found {
+	if _, found := k.GetToken(ctx, req.Symbol); !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
-	} else { //nolint:revive // fixing this causes t to be inaccessible, so let's leave all as is.
-		accAddress, _ := sdk.AccAddressFromBech32(req.Address)
-		isAuthorized := t.AddressIsAuthorized(accAddress)
-		return &types.QueryIsAuthorizedResponse{IsAuthorized: isAuthorized}, nil
 	}
+
+	accAddress, _ := sdk.AccAddressFromBech32(req.Address)
+	isAuthorized := k.IsAddressAuthorizedToSend(ctx, req.Symbol, accAddress)
+	return &types.QueryIsAuthorizedResponse{IsAuthorized: isAuthorized}, nil
+}
+
+func (k Keeper) RedemptionRequests(c context.Context, req *types.QueryRedemptionRequestsRequest) (*types.QueryRedemptionRequestsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetToken(ctx, req.Symbol); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+
+	return &types.QueryRedemptionRequestsResponse{Redemptions: k.GetTokenRedemptionRequests(ctx, req.Symbol)}, nil
+}
+
+func (k Keeper) Roles(c context.Context, req *types.QueryRolesRequest) (*types.QueryRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	t, found := k.GetToken(ctx, req.Symbol)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+
+	return &types.QueryRolesResponse{Manager: t.Manager, Roles: k.GetTokenRoles(ctx, req.Symbol)}, nil
+}
+
+func (k Keeper) IsFrozen(c context.Context, req *types.QueryIsFrozenRequest) (*types.QueryIsFrozenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetToken(ctx, req.Symbol); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	return &types.QueryIsFrozenResponse{IsFrozen: k.IsAddressFrozen(ctx, req.Symbol, accAddress)}, nil
+}
+
+func (k Keeper) FrozenAddresses(c context.Context, req *types.QueryFrozenAddressesRequest) (*types.QueryFrozenAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetToken(ctx, req.Symbol); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+
+	return &types.QueryFrozenAddressesResponse{Frozen: k.GetTokenFrozenAddresses(ctx, req.Symbol)}, nil
+}
+
+func (k Keeper) AuthorizedAddresses(c context.Context, req *types.QueryAuthorizedAddressesRequest) (*types.QueryAuthorizedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetToken(ctx, req.Symbol); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationKeyPrefix))
+	store = prefix.NewStore(store, types.TokenKey(strings.ToLower(req.Symbol)))
+
+	var authorizations []types.TokenAuthorization
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var authorization types.TokenAuthorization
+		if err := k.cdc.Unmarshal(value, &authorization); err != nil {
+			return err
+		}
+		authorizations = append(authorizations, authorization)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthorizedAddressesResponse{Authorizations: authorizations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) AddressAuthorizations(c context.Context, req *types.QueryAddressAuthorizationsRequest) (*types.QueryAddressAuthorizationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s", req.Address)
+	}
+
+	// the address index only holds keys, the authorization itself is read from the symbol/address store
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AddressAuthorizationKeyPrefix))
+	store = prefix.NewStore(store, types.TokenKey(req.Address))
+
+	var authorizations []types.TokenAuthorization
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		symbol := strings.TrimSuffix(string(key), "/")
+		if authorization, found := k.GetAuthorization(ctx, symbol, req.Address); found {
+			authorizations = append(authorizations, authorization)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAddressAuthorizationsResponse{Authorizations: authorizations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) HolderCount(c context.Context, req *types.QueryHolderCountRequest) (*types.QueryHolderCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetToken(ctx, req.Symbol); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+
+	return &types.QueryHolderCountResponse{Count: k.GetHolderCount(ctx, req.Symbol)}, nil
+}
+
+func (k Keeper) IsDenied(c context.Context, req *types.QueryIsDeniedRequest) (*types.QueryIsDeniedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetToken(ctx, req.Symbol); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	return &types.QueryIsDeniedResponse{IsDenied: k.IsAddressDenied(ctx, req.Symbol, accAddress)}, nil
+}
+
+func (k Keeper) DeniedAddresses(c context.Context, req *types.QueryDeniedAddressesRequest) (*types.QueryDeniedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetToken(ctx, req.Symbol); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeniedKeyPrefix))
+	store = prefix.NewStore(store, types.TokenKey(strings.ToLower(req.Symbol)))
+
+	var denied []types.DeniedAddress
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var address types.DeniedAddress
+		if err := k.cdc.Unmarshal(value, &address); err != nil {
+			return err
+		}
+		denied = append(denied, address)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeniedAddressesResponse{Denied: denied, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Distribution(c context.Context, req *types.QueryDistributionRequest) (*types.QueryDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	distribution, found := k.GetDistribution(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+
+	return &types.QueryDistributionResponse{Distribution: distribution}, nil
+}
+
+func (k Keeper) PendingClaims(c context.Context, req *types.QueryPendingClaimsRequest) (*types.QueryPendingClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DistributionClaimKeyPrefix))
+	store = prefix.NewStore(store, types.TokenKey(req.Address))
+
+	var claims []types.DistributionClaim
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var claim types.DistributionClaim
+		if err := k.cdc.Unmarshal(value, &claim); err != nil {
+			return err
+		}
+		claims = append(claims, claim)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the first page also lists the shares the EndBlocker did not allocate yet
+	if req.Pagination == nil || (len(req.Pagination.Key) == 0 && req.Pagination.Offset == 0) {
+		address, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		for _, distribution := range k.getAllocatingDistributions(ctx) {
+			token, found := k.GetToken(ctx, distribution.Symbol)
+			if !found {
+				continue
+			}
+			if claim, found := k.distributionShare(ctx, distribution, token, address); found {
+				claims = append(claims, claim)
+			}
+		}
+	}
+
+	return &types.QueryPendingClaimsResponse{Claims: claims, Pagination: pageRes}, nil
+}
+
+func (k Keeper) BalanceAt(c context.Context, req *types.QueryBalanceAtRequest) (*types.QueryBalanceAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	token, found := k.GetToken(ctx, req.Symbol)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	balance, err := k.GetBalanceAt(ctx, token, address, req.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBalanceAtResponse{Balance: balance.String()}, nil
+}
+
+func (k Keeper) Settlement(c context.Context, req *types.QuerySettlementRequest) (*types.QuerySettlementResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	settlement, found := k.GetSettlement(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "not found")
+	}
+
+	return &types.QuerySettlementResponse{Settlement: settlement}, nil
 }
@@ -4,7 +4,10 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/realiotech/realio-network/x/asset/keeper"
 	"github.com/realiotech/realio-network/x/asset/types"
 )
 
@@ -107,3 +110,97 @@ func (suite *KeeperTestSuite) TestTokensQuery() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTokensQueryFilters() {
+	suite.SetupTest()
+
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	for _, token := range []types.Token{
+		{Manager: suite.testUser1Address, Name: "realio", Symbol: "rio", Total: "1000"},
+		{Manager: suite.testUser1Address, Name: "realio share", Symbol: "rst", Total: "1000", AuthorizationRequired: true},
+		{Manager: suite.testUser2Address, Name: "bitcoinEtf", Symbol: "btf", Total: "1000", AuthorizationRequired: true},
+	} {
+		suite.app.AssetKeeper.SetToken(suite.ctx, token)
+	}
+
+	symbols := func(res *types.QueryTokensResponse) (list []string) {
+		for _, token := range res.Tokens {
+			list = append(list, token.Symbol)
+		}
+		return
+	}
+
+	res, err := suite.queryClient.Tokens(wctx, &types.QueryTokensRequest{Manager: suite.testUser1Address})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"rio", "rst"}, symbols(res))
+
+	res, err = suite.queryClient.Tokens(wctx, &types.QueryTokensRequest{AuthorizationRequired: "true"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"btf", "rst"}, symbols(res))
+
+	res, err = suite.queryClient.Tokens(wctx, &types.QueryTokensRequest{SymbolPrefix: "R"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"rio", "rst"}, symbols(res))
+
+	res, err = suite.queryClient.Tokens(wctx, &types.QueryTokensRequest{SymbolPrefix: "r", AuthorizationRequired: "false"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"rio"}, symbols(res))
+
+	_, err = suite.queryClient.Tokens(wctx, &types.QueryTokensRequest{AuthorizationRequired: "maybe"})
+	suite.Require().Error(err)
+
+	// page through the tokens one at a time
+	res, err = suite.queryClient.Tokens(wctx, &types.QueryTokensRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"btf"}, symbols(res))
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = suite.queryClient.Tokens(wctx, &types.QueryTokensRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"rio"}, symbols(res))
+}
+
+func (suite *KeeperTestSuite) TestAuthorizationsQueries() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	investor := suite.testUser2Address
+
+	for _, symbol := range []string{"RIO", "RST"} {
+		_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager, Name: symbol, Symbol: symbol, Total: "1000", AuthorizationRequired: true})
+		suite.Require().NoError(err)
+		_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager, Symbol: symbol, Address: investor})
+		suite.Require().NoError(err)
+	}
+	_, err := srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager, Symbol: "RST", Address: suite.testUser3Address})
+	suite.Require().NoError(err)
+	_, err = srv.UnAuthorizeAddress(wctx, &types.MsgUnAuthorizeAddress{Manager: manager, Symbol: "RIO", Address: investor})
+	suite.Require().NoError(err)
+
+	// module account, manager and the two investors
+	res, err := suite.queryClient.AuthorizedAddresses(wctx, types.NewQueryAuthorizedAddressesRequest("RST", &query.PageRequest{CountTotal: true}))
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Authorizations, 4)
+	suite.Require().Equal(uint64(4), res.Pagination.Total)
+
+	res, err = suite.queryClient.AuthorizedAddresses(wctx, types.NewQueryAuthorizedAddressesRequest("RIO", nil))
+	suite.Require().NoError(err)
+	for _, authorization := range res.Authorizations {
+		suite.Require().NotEqual(investor, authorization.Address)
+	}
+
+	_, err = suite.queryClient.AuthorizedAddresses(wctx, types.NewQueryAuthorizedAddressesRequest("BTF", nil))
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	// the unauthorized token is gone from the reverse view
+	addrRes, err := suite.queryClient.AddressAuthorizations(wctx, types.NewQueryAddressAuthorizationsRequest(investor, nil))
+	suite.Require().NoError(err)
+	suite.Require().Len(addrRes.Authorizations, 1)
+	suite.Require().Equal("rst", addrRes.Authorizations[0].Symbol)
+
+	addrRes, err = suite.queryClient.AddressAuthorizations(wctx, types.NewQueryAddressAuthorizationsRequest(manager, nil))
+	suite.Require().NoError(err)
+	suite.Require().Len(addrRes.Authorizations, 2)
+}
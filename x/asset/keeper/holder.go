@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// GetHolderCount returns the number of accounts holding a token
+func (k Keeper) GetHolderCount(ctx sdk.Context, symbol string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HolderCountKeyPrefix))
+	b := store.Get(types.TokenKey(strings.ToLower(symbol)))
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// IsHolder returns whether the address is counted as a holder of the token
+func (k Keeper) IsHolder(ctx sdk.Context, symbol string, address sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HolderKeyPrefix))
+	return store.Has(types.HolderKey(strings.ToLower(symbol), address.String()))
+}
+
+// ResetHolders rebuilds the holders of every token from the bank balances.
+// It iterates over all balances and is only meant for genesis and migrations,
+// the holders are kept up to date by AssetSendRestriction afterwards.
+func (k Keeper) ResetHolders(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, keyPrefix := range []string{types.HolderKeyPrefix, types.HolderCountKeyPrefix} {
+		prefixStore := prefix.NewStore(store, types.KeyPrefix(keyPrefix))
+		iterator := sdk.KVStorePrefixIterator(prefixStore, []byte{})
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			prefixStore.Delete(key)
+		}
+	}
+
+	denoms := make(map[string]string)
+	for _, token := range k.GetAllToken(ctx) {
+		denoms[tokenDenom(token)] = token.Symbol
+	}
+
+	k.bankKeeper.IterateAllBalances(ctx, func(address sdk.AccAddress, coin sdk.Coin) bool {
+		symbol, found := denoms[coin.Denom]
+		if found && coin.Amount.IsPositive() && !k.AllowAddr(address) && !k.IsTransferEscrow(ctx, address) {
+			k.setHolder(ctx, symbol, address)
+		}
+		return false
+	})
+}
+
+// setHolder counts the address as a holder of the token
+func (k Keeper) setHolder(ctx sdk.Context, symbol string, address sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HolderKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	key := types.HolderKey(lowerCased, address.String())
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, []byte{})
+	k.setHolderCount(ctx, lowerCased, k.GetHolderCount(ctx, lowerCased)+1)
+}
+
+// removeHolder stops counting the address as a holder of the token
+func (k Keeper) removeHolder(ctx sdk.Context, symbol string, address sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HolderKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	key := types.HolderKey(lowerCased, address.String())
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+	k.setHolderCount(ctx, lowerCased, k.GetHolderCount(ctx, lowerCased)-1)
+}
+
+func (k Keeper) setHolderCount(ctx sdk.Context, symbol string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HolderCountKeyPrefix))
+	store.Set(types.TokenKey(symbol), sdk.Uint64ToBigEndian(count))
+}
+
+// getHolders returns the accounts holding a token, ordered by address
+func (k Keeper) getHolders(ctx sdk.Context, symbol string) (list []sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HolderKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenKey(strings.ToLower(symbol)))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := strings.TrimSuffix(string(iterator.Key()), "/")
+		address, err := sdk.AccAddressFromBech32(key[strings.LastIndex(key, "/")+1:])
+		if err != nil {
+			panic(err)
+		}
+		list = append(list, address)
+	}
+
+	return
+}
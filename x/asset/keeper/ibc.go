@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// CheckIBCSend checks that coin may leave the chain over an IBC transfer.
+// Tokens in allowlist mode only leave the chain once they opt into IBC, as
+// the other chain does not know who is authorized to hold them.
+func (k Keeper) CheckIBCSend(ctx sdk.Context, coin sdk.Coin) error {
+	token, found := k.GetTokenByDenom(ctx, coin.Denom)
+	if !found {
+		return nil
+	}
+
+	if token.EffectiveRestrictionMode() == types.RestrictionModeAllowlist && !token.IbcEnabled {
+		return sdkerrors.Wrapf(types.ErrIBCTransferNotAllowed, "%s does not allow ibc transfers", token.Symbol)
+	}
+
+	return nil
+}
+
+// CheckIBCReceive checks that coin returning to the chain over an IBC transfer
+// can be released from escrow to receiver, as for any other send of the token
+func (k Keeper) CheckIBCReceive(ctx sdk.Context, escrow, receiver sdk.AccAddress, coin sdk.Coin) error {
+	token, found := k.GetTokenByDenom(ctx, coin.Denom)
+	if !found {
+		return nil
+	}
+
+	return k.checkTransfer(ctx, token, types.ComplianceTransfer{From: escrow, To: receiver, Coin: coin})
+}
+
+// IsTransferEscrow checks if address is the ICS-20 escrow account of a channel
+// on the transfer port. Like module accounts, escrow accounts hold units on
+// behalf of others, so they need no authorization and are not token holders.
+func (k Keeper) IsTransferEscrow(ctx sdk.Context, address sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TransferEscrowKeyPrefix))
+	return store.Has(types.TransferEscrowKey(address))
+}
+
+// SetTransferEscrow records the ICS-20 escrow account of a channel on the
+// transfer port, when the channel opens
+func (k Keeper) SetTransferEscrow(ctx sdk.Context, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TransferEscrowKeyPrefix))
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, channelID)
+	store.Set(types.TransferEscrowKey(escrow), []byte(channelID))
+}
+
+// ResetTransferEscrows records the escrow accounts of the channels on the
+// transfer port. It iterates over all channels and is only meant for genesis
+// and migrations, the channels opened afterwards are recorded by the IBC
+// middleware.
+func (k Keeper) ResetTransferEscrows(ctx sdk.Context) {
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, transfertypes.PortID) {
+		if channel.PortId == transfertypes.PortID {
+			k.SetTransferEscrow(ctx, channel.ChannelId)
+		}
+	}
+}
+
+// transferEscrows returns the ICS-20 escrow accounts of the channels on the transfer port
+func (k Keeper) transferEscrows(ctx sdk.Context) (list []sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TransferEscrowKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		list = append(list, sdk.AccAddress(key[:len(key)-1]))
+	}
+
+	return
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestIBCTransferChecks() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	coin := sdk.NewCoin("arst", sdk.NewInt(10))
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager, Name: "realio security token",
+		Symbol: "RST", Total: "1000", AuthorizationRequired: true,
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	// allowlist tokens stay on the chain until they opt into ibc
+	err = suite.app.AssetKeeper.CheckIBCSend(suite.ctx, coin)
+	suite.Require().ErrorIs(err, types.ErrIBCTransferNotAllowed)
+	_, err = srv.SetIBCEnabled(wctx, &types.MsgSetIBCEnabled{Manager: suite.testUser2Address, Symbol: "RST", Enabled: true})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SetIBCEnabled(wctx, &types.MsgSetIBCEnabled{Manager: manager, Symbol: "RST", Enabled: true})
+	suite.Require().NoError(err)
+	err = suite.app.AssetKeeper.CheckIBCSend(suite.ctx, coin)
+	suite.Require().NoError(err)
+
+	// other denoms are left to the transfer module
+	err = suite.app.AssetKeeper.CheckIBCSend(suite.ctx, sdk.NewCoin("ario", sdk.NewInt(10)))
+	suite.Require().NoError(err)
+
+	// returning tokens are only released to authorized receivers
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager, Symbol: "RST", Address: escrow.String()})
+	suite.Require().NoError(err)
+	err = suite.app.AssetKeeper.CheckIBCReceive(suite.ctx, escrow, suite.testUser2Acc, coin)
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager, Symbol: "RST", Address: suite.testUser2Address})
+	suite.Require().NoError(err)
+	err = suite.app.AssetKeeper.CheckIBCReceive(suite.ctx, escrow, suite.testUser2Acc, coin)
+	suite.Require().NoError(err)
+
+	// open tokens leave the chain without opting in
+	_, err = srv.SetIBCEnabled(wctx, &types.MsgSetIBCEnabled{Manager: manager, Symbol: "RST", Enabled: false})
+	suite.Require().NoError(err)
+	_, err = srv.SetRestrictionMode(wctx, &types.MsgSetRestrictionMode{Manager: manager, Symbol: "RST", Mode: types.RestrictionModeOpen})
+	suite.Require().NoError(err)
+	err = suite.app.AssetKeeper.CheckIBCSend(suite.ctx, coin)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// SetAddressJurisdiction records the jurisdiction of a holder of a token in the store
+func (k Keeper) SetAddressJurisdiction(ctx sdk.Context, jurisdiction types.AddressJurisdiction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.JurisdictionKeyPrefix))
+	lowerCased := strings.ToLower(jurisdiction.Symbol)
+	jurisdiction.Symbol = lowerCased
+	b := k.cdc.MustMarshal(&jurisdiction)
+	store.Set(types.JurisdictionKey(
+		lowerCased,
+		jurisdiction.Address,
+	), b)
+}
+
+// RemoveAddressJurisdiction removes the jurisdiction of a holder of a token from the store
+func (k Keeper) RemoveAddressJurisdiction(ctx sdk.Context, symbol string, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.JurisdictionKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	store.Delete(types.JurisdictionKey(
+		lowerCased,
+		address,
+	))
+}
+
+// GetJurisdiction returns the jurisdiction of a holder of a token, empty if it has none
+func (k Keeper) GetJurisdiction(ctx sdk.Context, symbol string, address sdk.AccAddress) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.JurisdictionKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	b := store.Get(types.JurisdictionKey(
+		lowerCased,
+		address.String(),
+	))
+	if b == nil {
+		return ""
+	}
+
+	var val types.AddressJurisdiction
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Jurisdiction
+}
+
+// GetAllAddressJurisdiction returns all holder jurisdictions
+func (k Keeper) GetAllAddressJurisdiction(ctx sdk.Context) (list []types.AddressJurisdiction) {
+	return k.getAddressJurisdictions(ctx, []byte{})
+}
+
+// GetTokenAddressJurisdictions returns the jurisdictions of all holders of a token
+func (k Keeper) GetTokenAddressJurisdictions(ctx sdk.Context, symbol string) (list []types.AddressJurisdiction) {
+	return k.getAddressJurisdictions(ctx, types.TokenKey(strings.ToLower(symbol)))
+}
+
+func (k Keeper) getAddressJurisdictions(ctx sdk.Context, keyPrefix []byte) (list []types.AddressJurisdiction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.JurisdictionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AddressJurisdiction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 
 	"github.com/realiotech/realio-network/x/asset/types"
 )
@@ -18,39 +20,124 @@ type (
 		cdc        codec.BinaryCodec
 		storeKey   storetypes.StoreKey
 		memKey     storetypes.StoreKey
+		tkey       storetypes.StoreKey
 		paramstore paramtypes.Subspace
 		bankKeeper types.BankKeeper
 		ak         types.AccountKeeper
 		allowAddrs map[string]bool
+		// rules are the compliance rules tokens can enable, by id
+		rules map[string]types.ComplianceRule
+
+		channelKeeper types.ChannelKeeper
+		portKeeper    types.PortKeeper
+		scopedKeeper  types.ScopedKeeper
+
+		evmKeeper types.EVMKeeper
 	}
 )
 
 // NewKeeper returns a new Keeper object with a given codec, dedicated
 // store key, a BankKeeper implementation, an AccountKeeper implementation, and a parameter Subspace used to
 // store and fetch module parameters. It also has an allowAddrs map[string]bool to skip restrictions for module addresses.
+// The IBC channel, port and scoped keepers serve the asset ibc application, the
+// EVM keeper and the transient store key serve the ERC-20 facades of the tokens.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey,
-	memKey storetypes.StoreKey,
+	memKey,
+	tkey storetypes.StoreKey,
 	ps paramtypes.Subspace,
 	bankKeeper types.BankKeeper,
 	ak types.AccountKeeper,
 	allowAddrs map[string]bool,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper types.ScopedKeeper,
+	evmKeeper types.EVMKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &Keeper{
+	k := &Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
 		memKey:     memKey,
+		tkey:       tkey,
 		paramstore: ps,
 		bankKeeper: bankKeeper,
 		ak:         ak,
 		allowAddrs: allowAddrs,
+		rules:      make(map[string]types.ComplianceRule),
+
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
+
+		evmKeeper: evmKeeper,
 	}
+	for _, rule := range types.BuiltinComplianceRules() {
+		k.RegisterComplianceRule(rule)
+	}
+
+	return k
+}
+
+// RegisterComplianceRule makes a compliance rule available to tokens. It panics
+// if a rule with the same id is already registered, and must be called while
+// the app is wired, before any block is processed.
+func (k Keeper) RegisterComplianceRule(rule types.ComplianceRule) {
+	if _, found := k.rules[rule.ID()]; found {
+		panic(fmt.Sprintf("compliance rule %s already registered", rule.ID()))
+	}
+	k.rules[rule.ID()] = rule
+}
+
+// GetComplianceRule returns a registered compliance rule from its id
+func (k Keeper) GetComplianceRule(id string) (types.ComplianceRule, bool) {
+	rule, found := k.rules[id]
+	return rule, found
+}
+
+// GetBalance returns the balance of an address in a denom
+func (k Keeper) GetBalance(ctx sdk.Context, address sdk.AccAddress, denom string) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, address, denom)
+}
+
+// IsBound checks if the module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the portID for the module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the module to claim a capability that the IBC module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
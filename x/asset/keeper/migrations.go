@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+
+	v2 "github.com/realiotech/realio-network/x/asset/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The tokens get their decimals and
+// their authorizations move to their own store, then the ICS-20 escrow accounts
+// of the open channels are recorded and the holders of every token are counted
+// from the bank balances, both are kept up to date incrementally afterwards. The
+// module binds the asset port so that tokens can be replicated and sent over
+// IBC, and gets its params, no channel can replicate tokens until governance
+// approves it. Last, every token gets its ERC-20 facade, filled from the bank
+// balances, and the asset registry is deployed with all the tokens and
+// authorizations.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+
+	m.keeper.ResetTransferEscrows(ctx)
+	m.keeper.ResetHolders(ctx)
+
+	m.keeper.SetPort(ctx, types.PortID)
+	if !m.keeper.IsBound(ctx, types.PortID) {
+		if err := m.keeper.BindPort(ctx, types.PortID); err != nil {
+			return err
+		}
+	}
+
+	m.keeper.SetParams(ctx, types.DefaultParams())
+
+	if err := m.keeper.ResetERC20Facades(ctx); err != nil {
+		return err
+	}
+	return m.keeper.ResetAssetRegistry(ctx)
+}
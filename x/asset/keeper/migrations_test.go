@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: suite.testUser1Address, Name: "realio security token", Symbol: "RST", Total: "1000"})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: suite.testUser1Address, To: suite.testUser2Address, Amount: "10"})
+	suite.Require().NoError(err)
+
+	// tokens stored before v2 carry no decimals and keep their authorizations inline
+	token, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "RST")
+	token.Decimals = 0
+	token.Authorized = []*types.TokenAuthorization{
+		{Address: suite.testUser2Address, Authorized: true},
+		{Address: suite.testUser3Address, Authorized: false},
+	}
+	suite.app.AssetKeeper.SetToken(suite.ctx, token)
+
+	// their balances and escrow accounts were never recorded, the asset port
+	// was not stored and there were no ERC-20 facades
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	store.Delete(append(types.KeyPrefix(types.HolderCountKeyPrefix), types.TokenKey("rst")...))
+	suite.app.AssetKeeper.SetPort(suite.ctx, "")
+	facade := types.ERC20Address("RST")
+	slot := types.ERC20BalanceSlot(common.BytesToAddress(suite.testUser1Acc))
+	suite.app.EvmKeeper.SetState(suite.ctx, facade, slot, nil)
+	suite.app.IBCKeeper.ChannelKeeper.SetChannel(suite.ctx, transfertypes.PortID, "channel-0", channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(transfertypes.PortID, "channel-1"),
+		[]string{"connection-0"}, transfertypes.Version,
+	))
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	suite.Require().False(suite.app.AssetKeeper.IsTransferEscrow(suite.ctx, escrow))
+
+	m := keeper.NewMigrator(suite.app.AssetKeeper)
+	suite.Require().NoError(m.Migrate1to2(suite.ctx))
+
+	migrated, found := suite.app.AssetKeeper.GetToken(suite.ctx, "rst")
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(types.MaxDecimals), migrated.Decimals)
+	suite.Require().Equal("1000", migrated.Total)
+	suite.Require().Empty(migrated.Authorized)
+
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", suite.testUser2Acc))
+	suite.Require().False(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", suite.testUser3Acc))
+	res, err := suite.queryClient.AddressAuthorizations(sdk.WrapSDKContext(suite.ctx), types.NewQueryAddressAuthorizationsRequest(suite.testUser2Address, nil))
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Authorizations, 1)
+
+	suite.Require().True(suite.app.AssetKeeper.IsTransferEscrow(suite.ctx, escrow))
+	suite.Require().Equal(uint64(2), suite.app.AssetKeeper.GetHolderCount(suite.ctx, "RST"))
+	suite.Require().True(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", suite.testUser2Acc))
+	suite.Require().False(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", suite.testUser3Acc))
+
+	suite.Require().Equal(types.PortID, suite.app.AssetKeeper.GetPort(suite.ctx))
+	suite.Require().True(suite.app.AssetKeeper.IsBound(suite.ctx, types.PortID))
+	suite.Require().Equal(types.DefaultParams(), suite.app.AssetKeeper.GetParams(suite.ctx))
+
+	symbol, found := suite.app.AssetKeeper.GetERC20Symbol(suite.ctx, facade)
+	suite.Require().True(found)
+	suite.Require().Equal("rst", symbol)
+	account, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, sdk.AccAddress(facade.Bytes())).(ethermint.EthAccountI)
+	suite.Require().True(ok)
+	suite.Require().Equal(types.ERC20FacadeCodeHash, account.GetCodeHash())
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser1Acc, "arst").Amount
+	suite.Require().Equal(balance.BigInt(), suite.app.EvmKeeper.GetState(suite.ctx, facade, slot).Big())
+
+	registry, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, sdk.AccAddress(types.AssetRegistryAddress.Bytes())).(ethermint.EthAccountI)
+	suite.Require().True(ok)
+	suite.Require().Equal(types.AssetRegistryCodeHash, registry.GetCodeHash())
+	erc20Slot := types.AssetRegistryTokenSlot("rst", types.AssetRegistryERC20Field)
+	suite.Require().Equal(facade.Hash(), suite.app.EvmKeeper.GetState(suite.ctx, types.AssetRegistryAddress, erc20Slot))
+	authorizationSlot := types.AssetRegistryAuthorizationSlot("rst", common.BytesToAddress(suite.testUser2Acc))
+	suite.Require().NotEqual(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, types.AssetRegistryAddress, authorizationSlot))
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) AcceptManager(goCtx context.Context, msg *types.MsgAcceptManager) (*types.MsgAcceptManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the proposed manager is the only signer of the message
+	if token.PendingManager == "" || signers[0].String() != token.PendingManager {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not the pending manager")
+	}
+
+	previousManager := token.Manager
+	token.Manager = token.PendingManager
+	token.PendingManager = ""
+
+	// like at creation, the manager of a restricted token must be able to hold it
+	if token.EffectiveRestrictionMode() == types.RestrictionModeAllowlist {
+		k.SetAuthorization(ctx, types.NewAuthorization(token.Symbol, signers[0]))
+	}
+
+	k.SetToken(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeManagerAccepted,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyManager, previousManager),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.NewManager),
+		),
+	)
+
+	return &types.MsgAcceptManagerResponse{}, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) AffirmSettlement(goCtx context.Context, msg *types.MsgAffirmSettlement) (*types.MsgAffirmSettlementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	settlement, isFound := k.GetSettlement(ctx, msg.Id)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "settlement %d does not exists", msg.Id)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer is the counterparty of the settlement
+	if signers[0].String() != settlement.Counterparty {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	// the EndBlocker refunds the settlement at the end of the block it expires in
+	if !ctx.BlockTime().Before(settlement.Expiry) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "settlement %d expired at %s", settlement.Id, settlement.Expiry)
+	}
+
+	if err := k.affirmSettlement(ctx, settlement); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettle,
+			sdk.NewAttribute(types.AttributeKeySettlement, strconv.FormatUint(settlement.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, settlement.Creator),
+			sdk.NewAttribute(types.AttributeKeyCounterparty, settlement.Counterparty),
+		),
+	)
+
+	return &types.MsgAffirmSettlementResponse{}, nil
+}
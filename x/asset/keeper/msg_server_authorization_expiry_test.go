@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestAuthorizationExpiry() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	investor := suite.testUser2Address
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "1000", AuthorizationRequired: true})
+	suite.Require().NoError(err)
+
+	past := suite.ctx.BlockTime().Add(-time.Hour)
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager, Symbol: "RST", Address: investor, Expiry: &past})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager, Symbol: "RST", Address: investor, Expiry: &expiry})
+	suite.Require().NoError(err)
+
+	// a renewed authorization drops its previous expiry
+	_, err = srv.BatchAuthorize(wctx, types.NewMsgBatchAuthorize(manager, "RST", []string{suite.testUser3Address}))
+	suite.Require().NoError(err)
+	batch := types.NewMsgBatchAuthorize(manager, "RST", []string{suite.testUser3Address})
+	batch.Expiry = &expiry
+	_, err = srv.BatchAuthorize(wctx, batch)
+	suite.Require().NoError(err)
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager, Symbol: "RST", Address: suite.testUser3Address})
+	suite.Require().NoError(err)
+
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: investor, Amount: "10"})
+	suite.Require().NoError(err)
+
+	// once the block time passes the expiry, the investor can no longer transact
+	suite.ctx = suite.ctx.WithBlockTime(expiry).WithEventManager(sdk.NewEventManager())
+	wctx = sdk.WrapSDKContext(suite.ctx)
+	suite.Require().False(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", suite.testUser2Acc))
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: investor, To: manager, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+
+	suite.app.AssetKeeper.ExpireAuthorizations(suite.ctx)
+
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeAuthorizationExpired, events[0].Type)
+	_, found := suite.app.AssetKeeper.GetAuthorization(suite.ctx, "RST", investor)
+	suite.Require().False(found)
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", suite.testUser3Acc))
+
+	// nothing is left to expire
+	suite.ctx = suite.ctx.WithBlockTime(expiry.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	suite.app.AssetKeeper.ExpireAuthorizations(suite.ctx)
+	suite.Require().Empty(suite.ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestAuthorizationExpiryPerBlock() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "1000", AuthorizationRequired: true})
+	suite.Require().NoError(err)
+
+	addresses := make([]string, types.MaxAuthorizationExpiriesPerBlock+1)
+	for i := range addresses {
+		addresses[i] = sdk.AccAddress(fmt.Sprintf("investor-%d", i)).String()
+	}
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	batch := types.NewMsgBatchAuthorize(manager, "RST", addresses)
+	batch.Expiry = &expiry
+	_, err = srv.BatchAuthorize(wctx, batch)
+	suite.Require().NoError(err)
+
+	// the queue is drained over as many blocks as it takes
+	suite.ctx = suite.ctx.WithBlockTime(expiry).WithEventManager(sdk.NewEventManager())
+	suite.app.AssetKeeper.ExpireAuthorizations(suite.ctx)
+	suite.Require().Len(suite.ctx.EventManager().Events(), types.MaxAuthorizationExpiriesPerBlock)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.app.AssetKeeper.ExpireAuthorizations(suite.ctx)
+	suite.Require().Len(suite.ctx.EventManager().Events(), 1)
+	suite.Require().Len(suite.app.AssetKeeper.GetTokenAuthorizations(suite.ctx, "RST"), 2)
+}
@@ -18,24 +18,34 @@ func (k msgServer) AuthorizeAddress(goCtx context.Context, msg *types.MsgAuthori
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
 	}
 
-	// Checks if the token manager signed
+	// Checks if a single account signed
 	signers := msg.GetSigners()
 	if len(signers) != 1 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
 	}
 
-	// assert that the manager account is the only signer of the message
-	if signers[0].String() != token.Manager {
+	// assert that the signer holds the compliance role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleCompliance) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
 	}
 
+	// the compliance data of a replica follows its issuing chain
+	if token.OriginChannel != "" {
+		return nil, sdkerrors.Wrapf(types.ErrReplicatedToken, "%s is issued over %s", msg.Symbol, token.OriginChannel)
+	}
+
 	accAddress, err := sdk.AccAddressFromBech32(msg.Address)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid address")
 	}
 
-	token.AuthorizeAddress(accAddress)
-	k.SetToken(ctx, token)
+	if msg.Expiry != nil && !msg.Expiry.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expiry %s is not after the block time", msg.Expiry)
+	}
+
+	authorization := types.NewAuthorization(token.Symbol, accAddress)
+	authorization.Expiry = msg.Expiry
+	k.SetAuthorization(ctx, authorization)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
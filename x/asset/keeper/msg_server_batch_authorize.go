@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) BatchAuthorize(goCtx context.Context, msg *types.MsgBatchAuthorize) (*types.MsgBatchAuthorizeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the compliance role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleCompliance) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	// the compliance data of a replica follows its issuing chain
+	if token.OriginChannel != "" {
+		return nil, sdkerrors.Wrapf(types.ErrReplicatedToken, "%s is issued over %s", msg.Symbol, token.OriginChannel)
+	}
+
+	// parse every address before touching the store so the batch is applied as a whole
+	addresses := make([]sdk.AccAddress, len(msg.Addresses))
+	for i, address := range msg.Addresses {
+		accAddress, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s", address)
+		}
+		addresses[i] = accAddress
+	}
+
+	if msg.Expiry != nil && !msg.Expiry.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expiry %s is not after the block time", msg.Expiry)
+	}
+
+	for _, address := range addresses {
+		authorization := types.NewAuthorization(token.Symbol, address)
+		authorization.Expiry = msg.Expiry
+		k.SetAuthorization(ctx, authorization)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTokenAuthorized,
+				sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+				sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+			),
+		)
+	}
+
+	return &types.MsgBatchAuthorizeResponse{}, nil
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestBatchAuthorize() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	cohort := []string{suite.testUser2Address, suite.testUser3Address}
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "1000", AuthorizationRequired: true})
+	suite.Require().NoError(err)
+
+	// only the manager or a compliance officer can authorize
+	_, err = srv.BatchAuthorize(wctx, types.NewMsgBatchAuthorize(suite.testUser2Address, "RST", cohort))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// a single bad address rejects the whole batch
+	_, err = srv.BatchAuthorize(wctx, types.NewMsgBatchAuthorize(manager, "RST", append(cohort, "invalid")))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidAddress)
+	suite.Require().False(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", suite.testUser2Acc))
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	wctx = sdk.WrapSDKContext(suite.ctx)
+	_, err = srv.BatchAuthorize(wctx, types.NewMsgBatchAuthorize(manager, "RST", cohort))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", suite.testUser2Acc))
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", suite.testUser3Acc))
+	suite.Require().Len(suite.ctx.EventManager().Events(), len(cohort))
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	wctx = sdk.WrapSDKContext(suite.ctx)
+	_, err = srv.BatchUnAuthorize(wctx, types.NewMsgBatchUnAuthorize(manager, "RST", cohort))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", suite.testUser2Acc))
+	suite.Require().False(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", suite.testUser3Acc))
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "RST", suite.testUser1Acc))
+	for _, event := range suite.ctx.EventManager().Events() {
+		suite.Require().Equal(types.EventTypeTokenUnAuthorized, event.Type)
+	}
+}
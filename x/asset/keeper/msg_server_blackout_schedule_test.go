@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestBlackoutSchedule() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+	lockupEnd := suite.ctx.BlockTime().Add(time.Hour)
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager, Name: "realio security token",
+		Symbol: "RST", Total: "1000",
+		BlackoutWindows: []types.BlackoutWindow{{Start: suite.ctx.BlockTime(), End: lockupEnd}},
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	// the initial lockup blocks transfers but not moves out of module accounts
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "100"})
+	suite.Require().ErrorIs(err, types.ErrTransferBlackout)
+	_, err = srv.MintToken(wctx, &types.MsgMintToken{Manager: manager, Symbol: "RST", To: holder, Amount: "10"})
+	suite.Require().NoError(err)
+
+	// the window ends exclusively
+	suite.ctx = suite.ctx.WithBlockTime(lockupEnd)
+	wctx = sdk.WrapSDKContext(suite.ctx)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "100"})
+	suite.Require().NoError(err)
+
+	quietPeriod := types.BlackoutWindow{Start: lockupEnd.Add(-time.Minute), End: lockupEnd.Add(time.Hour)}
+	_, err = srv.SetBlackoutSchedule(wctx, &types.MsgSetBlackoutSchedule{Manager: holder, Symbol: "RST", Windows: []types.BlackoutWindow{quietPeriod}})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SetBlackoutSchedule(wctx, &types.MsgSetBlackoutSchedule{Manager: manager, Symbol: "RST", Windows: []types.BlackoutWindow{quietPeriod}})
+	suite.Require().NoError(err)
+
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser2Acc, suite.testUser1Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(10))))
+	suite.Require().ErrorIs(err, types.ErrTransferBlackout)
+
+	_, err = srv.SetBlackoutSchedule(wctx, &types.MsgSetBlackoutSchedule{Manager: manager, Symbol: "RST"})
+	suite.Require().NoError(err)
+
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser2Acc, suite.testUser1Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(10))))
+	suite.Require().NoError(err)
+}
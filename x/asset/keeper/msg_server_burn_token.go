@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) BurnToken(goCtx context.Context, msg *types.MsgBurnToken) (*types.MsgBurnTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	holderAddress, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid holder address")
+	}
+
+	amount, isValid := math.NewIntFromString(msg.Amount)
+	if !isValid || !amount.IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid burn amount %s", msg.Amount)
+	}
+
+	// move the holder's units into the module account so they can be burned
+	coin := tokenCoins(token, amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holderAddress, types.ModuleName, coin); err != nil {
+		return nil, err
+	}
+
+	if err := k.burnTokens(ctx, token, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenBurned,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Holder),
+		),
+	)
+
+	return &types.MsgBurnTokenResponse{}, nil
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	realionetworktypes "github.com/realiotech/realio-network/types"
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestBurnToken() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	burnMsg := &types.MsgBurnToken{
+		Holder: manager,
+		Symbol: "RST", Amount: "400",
+	}
+	_, err = srv.BurnToken(wctx, burnMsg)
+	suite.Require().NoError(err)
+
+	rst, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "rst")
+	suite.Require().Equal("600", rst.Total)
+
+	expected := math.NewInt(600).Mul(realionetworktypes.PowerReduction)
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser1Acc, "arst")
+	suite.Require().Equal(expected, balance.Amount)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, "arst")
+	suite.Require().Equal(expected, supply.Amount)
+}
+
+func (suite *KeeperTestSuite) TestBurnTokenInsufficientBalance() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	t1 := &types.MsgCreateToken{
+		Manager: suite.testUser1Address,
+		Symbol:  "RST", Total: "1000",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	burnMsg := &types.MsgBurnToken{
+		Holder: suite.testUser2Address,
+		Symbol: "RST", Amount: "1",
+	}
+	_, err = srv.BurnToken(wctx, burnMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (suite *KeeperTestSuite) TestRedeemToken() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000", AuthorizationRequired: true,
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{
+		Manager: manager,
+		Symbol:  "RST", Address: holder,
+	})
+	suite.Require().NoError(err)
+
+	amount := math.NewInt(100).Mul(realionetworktypes.PowerReduction)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: amount.String()})
+	suite.Require().NoError(err)
+
+	// redeeming without a request fails
+	redeemMsg := &types.MsgRedeemToken{
+		Manager: manager,
+		Symbol:  "RST", Holder: holder,
+	}
+	_, err = srv.RedeemToken(wctx, redeemMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	for _, requested := range []string{"60", "40"} {
+		_, err = srv.RequestRedemption(wctx, &types.MsgRequestRedemption{
+			Holder: holder,
+			Symbol: "RST", Amount: requested,
+		})
+		suite.Require().NoError(err)
+	}
+
+	redemption, found := suite.app.AssetKeeper.GetRedemptionRequest(suite.ctx, "rst", holder)
+	suite.Require().True(found)
+	suite.Require().Equal("100", redemption.Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser2Acc, "arst").IsZero())
+
+	// only the manager can redeem
+	_, err = srv.RedeemToken(wctx, &types.MsgRedeemToken{
+		Manager: holder,
+		Symbol:  "RST", Holder: holder,
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.RedeemToken(wctx, redeemMsg)
+	suite.Require().NoError(err)
+
+	_, found = suite.app.AssetKeeper.GetRedemptionRequest(suite.ctx, "rst", holder)
+	suite.Require().False(found)
+
+	rst, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "rst")
+	suite.Require().Equal("900", rst.Total)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, "arst")
+	suite.Require().Equal(math.NewInt(900).Mul(realionetworktypes.PowerReduction), supply.Amount)
+}
+
+func (suite *KeeperTestSuite) TestCancelRedemption() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "1000"})
+	suite.Require().NoError(err)
+	amount := math.NewInt(100).Mul(realionetworktypes.PowerReduction)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: amount.String()})
+	suite.Require().NoError(err)
+
+	cancelMsg := &types.MsgCancelRedemption{Holder: holder, Symbol: "RST"}
+	_, err = srv.CancelRedemption(wctx, cancelMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.RequestRedemption(wctx, &types.MsgRequestRedemption{Holder: holder, Symbol: "RST", Amount: "60"})
+	suite.Require().NoError(err)
+
+	// the holder gets the escrowed units back even while the token is paused
+	_, err = srv.PauseToken(wctx, &types.MsgPauseToken{Manager: manager, Symbol: "RST"})
+	suite.Require().NoError(err)
+	_, err = srv.CancelRedemption(wctx, cancelMsg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount, suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser2Acc, "arst").Amount)
+	_, found := suite.app.AssetKeeper.GetRedemptionRequest(suite.ctx, "rst", holder)
+	suite.Require().False(found)
+
+	// and the manager has nothing left to redeem
+	_, err = srv.RedeemToken(wctx, &types.MsgRedeemToken{Manager: manager, Symbol: "RST", Holder: holder})
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+	rst, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "rst")
+	suite.Require().Equal("1000", rst.Total)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) CancelManagerProposal(goCtx context.Context, msg *types.MsgCancelManagerProposal) (*types.MsgCancelManagerProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if the token manager signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the manager account is the only signer of the message
+	if signers[0].String() != token.Manager {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	if token.PendingManager == "" {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no pending manager for %s", msg.Symbol)
+	}
+
+	pendingManager := token.PendingManager
+	token.PendingManager = ""
+	k.SetToken(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeManagerCancelled,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyManager, msg.Manager),
+			sdk.NewAttribute(types.AttributeKeyAddress, pendingManager),
+		),
+	)
+
+	return &types.MsgCancelManagerProposalResponse{}, nil
+}
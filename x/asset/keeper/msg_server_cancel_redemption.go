@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) CancelRedemption(goCtx context.Context, msg *types.MsgCancelRedemption) (*types.MsgCancelRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	holderAddress, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid holder address")
+	}
+
+	redemption, isFound := k.GetRedemptionRequest(ctx, msg.Symbol, msg.Holder)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "no redemption request for %s from %s", msg.Symbol, msg.Holder)
+	}
+
+	// the refund is not subject to the rules of the token, the units return
+	// to the account they were escrowed from
+	amount, _ := math.NewIntFromString(redemption.Amount)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(withRestrictionBypass(ctx), types.ModuleName, holderAddress, tokenCoins(token, amount)); err != nil {
+		return nil, err
+	}
+
+	k.RemoveRedemptionRequest(ctx, msg.Symbol, msg.Holder)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedemptionCancelled,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, redemption.Amount),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Holder),
+		),
+	)
+
+	return &types.MsgCancelRedemptionResponse{}, nil
+}
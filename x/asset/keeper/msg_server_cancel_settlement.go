@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) CancelSettlement(goCtx context.Context, msg *types.MsgCancelSettlement) (*types.MsgCancelSettlementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	settlement, isFound := k.GetSettlement(ctx, msg.Id)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "settlement %d does not exists", msg.Id)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer is the creator of the settlement
+	if signers[0].String() != settlement.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	refund, err := k.refundSettlement(ctx, settlement)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettlementCancelled,
+			sdk.NewAttribute(types.AttributeKeySettlement, strconv.FormatUint(settlement.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, settlement.Creator),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+		),
+	)
+
+	return &types.MsgCancelSettlementResponse{}, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) ClaimDistribution(goCtx context.Context, msg *types.MsgClaimDistribution) (*types.MsgClaimDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	distribution, isFound := k.GetDistribution(ctx, msg.DistributionId)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "distribution %d does not exists", msg.DistributionId)
+	}
+	token, _ := k.GetToken(ctx, distribution.Symbol)
+
+	holderAddress, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid holder address")
+	}
+
+	// shares the EndBlocker did not reach yet are allocated on claim
+	claim, isFound := k.GetDistributionClaim(ctx, msg.Holder, msg.DistributionId)
+	if !isFound && isAllocating(distribution) {
+		claim, isFound = k.allocateDistributionClaim(ctx, &distribution, token, holderAddress)
+	}
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "no claim on distribution %d for %s", msg.DistributionId, msg.Holder)
+	}
+
+	if !k.isEligibleHolder(ctx, token, holderAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrHolderNotEligible, "%s cannot receive distributions of %s", msg.Holder, distribution.Symbol)
+	}
+
+	// the paid event is emitted by payDistributionClaim, like for the EndBlocker payouts
+	if err := k.payDistributionClaim(ctx, distribution, claim); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimDistributionResponse{}, nil
+}
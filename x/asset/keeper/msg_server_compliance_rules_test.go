@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// maxTransferRule is a custom rule capping the base units moved by a single send
+type maxTransferRule struct{}
+
+func (maxTransferRule) ID() string { return "max_transfer" }
+
+func (maxTransferRule) ValidateParams(params string) error {
+	if _, ok := math.NewIntFromString(params); !ok {
+		return sdkerrors.ErrInvalidRequest
+	}
+	return nil
+}
+
+func (maxTransferRule) Check(_ sdk.Context, _ types.ComplianceState, _ types.Token, params string, transfer types.ComplianceTransfer) error {
+	limit, _ := math.NewIntFromString(params)
+	if transfer.Coin.Amount.GT(limit) {
+		return sdkerrors.ErrInvalidRequest.Wrap("transfer too large")
+	}
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestComplianceRules() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager, Name: "realio security token",
+		Symbol: "RST", Total: "1000",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	jurisdictionRule := types.ComplianceRuleConfig{Id: types.RuleIDJurisdiction, Params: "US,CA"}
+	_, err = srv.SetComplianceRules(wctx, &types.MsgSetComplianceRules{Manager: holder, Symbol: "RST", Rules: []types.ComplianceRuleConfig{jurisdictionRule}})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SetComplianceRules(wctx, &types.MsgSetComplianceRules{Manager: manager, Symbol: "RST", Rules: []types.ComplianceRuleConfig{{Id: "max_transfer", Params: "10"}}})
+	suite.Require().ErrorIs(err, types.ErrUnknownComplianceRule)
+	_, err = srv.SetComplianceRules(wctx, &types.MsgSetComplianceRules{Manager: manager, Symbol: "RST", Rules: []types.ComplianceRuleConfig{{Id: types.RuleIDJurisdiction}}})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	rules := []types.ComplianceRuleConfig{{Id: types.RuleIDFreeze}, jurisdictionRule}
+	_, err = srv.SetComplianceRules(wctx, &types.MsgSetComplianceRules{Manager: manager, Symbol: "RST", Rules: rules})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.SetComplianceRules(wctx, &types.MsgSetComplianceRules{Manager: manager, Symbol: "RST", Rules: rules[1:]})
+	suite.Require().NoError(err)
+
+	// both sides need an allowed jurisdiction
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "100"})
+	suite.Require().ErrorIs(err, types.ErrJurisdictionNotAllowed)
+	_, err = srv.SetJurisdiction(wctx, &types.MsgSetJurisdiction{Manager: manager, Symbol: "RST", Address: manager, Jurisdiction: "US"})
+	suite.Require().NoError(err)
+	_, err = srv.SetJurisdiction(wctx, &types.MsgSetJurisdiction{Manager: manager, Symbol: "RST", Address: holder, Jurisdiction: "DE"})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "100"})
+	suite.Require().ErrorIs(err, types.ErrJurisdictionNotAllowed)
+	_, err = srv.SetJurisdiction(wctx, &types.MsgSetJurisdiction{Manager: manager, Symbol: "RST", Address: holder, Jurisdiction: "CA"})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "100"})
+	suite.Require().NoError(err)
+
+	// the mandatory rules are checked along with the configured ones
+	_, err = srv.FreezeAddress(wctx, &types.MsgFreezeAddress{Manager: manager, Symbol: "RST", Address: holder})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: holder, To: manager, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+	_, err = srv.UnfreezeAddress(wctx, &types.MsgUnfreezeAddress{Manager: manager, Symbol: "RST", Address: holder})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: holder, To: manager, Amount: "10"})
+	suite.Require().NoError(err)
+
+	// custom rules are enabled like the built-in ones once registered
+	suite.app.AssetKeeper.RegisterComplianceRule(maxTransferRule{})
+	_, err = srv.SetComplianceRules(wctx, &types.MsgSetComplianceRules{Manager: manager, Symbol: "RST", Rules: []types.ComplianceRuleConfig{{Id: "max_transfer", Params: "10"}}})
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser2Acc, suite.testUser1Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(11))))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser2Acc, suite.testUser1Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(10))))
+	suite.Require().NoError(err)
+
+	// clearing the rules leaves the mandatory ones
+	_, err = srv.SetComplianceRules(wctx, &types.MsgSetComplianceRules{Manager: manager, Symbol: "RST"})
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser2Acc, suite.testUser1Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(11))))
+	suite.Require().NoError(err)
+	_, err = srv.FreezeAddress(wctx, &types.MsgFreezeAddress{Manager: manager, Symbol: "RST", Address: holder})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: holder, To: manager, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+}
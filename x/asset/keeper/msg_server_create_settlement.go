@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) CreateSettlement(goCtx context.Context, msg *types.MsgCreateSettlement) (*types.MsgCreateSettlementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	if !msg.Expiry.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expiry %s is not after the block time", msg.Expiry)
+	}
+
+	settlement, err := k.createSettlement(ctx, types.Settlement{
+		Creator:         signers[0].String(),
+		Counterparty:    msg.Counterparty,
+		CreatorLeg:      msg.CreatorLeg,
+		CounterpartyLeg: msg.CounterpartyLeg,
+		Expiry:          msg.Expiry,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateSettlement,
+			sdk.NewAttribute(types.AttributeKeySettlement, strconv.FormatUint(settlement.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, settlement.Creator),
+			sdk.NewAttribute(types.AttributeKeyCounterparty, settlement.Counterparty),
+			sdk.NewAttribute(types.AttributeKeyExpiry, settlement.Expiry.String()),
+		),
+	)
+
+	return &types.MsgCreateSettlementResponse{Id: settlement.Id}, nil
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) CreateSnapshot(goCtx context.Context, msg *types.MsgCreateSnapshot) (*types.MsgCreateSnapshotResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the issuer role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleIssuer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	snapshot := k.createSnapshot(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateSnapshot,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeySnapshot, strconv.FormatUint(snapshot.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(snapshot.Height, 10)),
+		),
+	)
+
+	return &types.MsgCreateSnapshotResponse{Id: snapshot.Id}, nil
+}
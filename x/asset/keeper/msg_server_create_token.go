@@ -5,10 +5,6 @@ import (
 	"fmt"
 	"strings"
 
-	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
-
-	realionetworktypes "github.com/realiotech/realio-network/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -22,7 +18,6 @@ func (k msgServer) CreateToken(goCtx context.Context, msg *types.MsgCreateToken)
 	// Check if the value already exists
 	lowerCaseSymbol := strings.ToLower(msg.Symbol)
 	lowerCaseName := strings.ToLower(msg.Name)
-	baseDenom := fmt.Sprintf("a%s", lowerCaseSymbol)
 
 	_, isFound := k.GetToken(
 		ctx,
@@ -37,34 +32,59 @@ func (k msgServer) CreateToken(goCtx context.Context, msg *types.MsgCreateToken)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid manager address")
 	}
 
+	if msg.MaxSupply != "" {
+		totalInt, _ := math.NewIntFromString(msg.Total)
+		maxSupply, _ := math.NewIntFromString(msg.MaxSupply)
+		if totalInt.GT(maxSupply) {
+			return nil, sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "total %s exceeds max supply %s", msg.Total, msg.MaxSupply)
+		}
+	}
+
+	decimals, err := types.ParseDecimals(msg.Decimals)
+	if err != nil {
+		return nil, err
+	}
+
 	token := types.NewToken(lowerCaseName, lowerCaseSymbol, msg.Total, msg.Manager, msg.AuthorizationRequired)
+	token.MaxSupply = msg.MaxSupply
+	token.ClawbackEnabled = msg.ClawbackEnabled
+	token.Decimals = decimals
+	token.Description = msg.Description
+	token.Uri = msg.Uri
+	token.UriHash = msg.UriHash
+	token.MaxHolders = msg.MaxHolders
+	token.MaxBalancePerHolder = msg.MaxBalancePerHolder
+	token.BlackoutWindows = msg.BlackoutWindows
+	token.IbcEnabled = msg.IbcEnabled
+	if msg.RestrictionMode != types.RestrictionModeUnspecified {
+		token.SetRestrictionMode(msg.RestrictionMode)
+	}
+
+	k.SetToken(ctx, token)
 
-	if msg.AuthorizationRequired {
+	if token.EffectiveRestrictionMode() == types.RestrictionModeAllowlist {
 		// create authorization for module account and manager
 		assetModuleAddress := k.ak.GetModuleAddress(types.ModuleName)
-		moduleAuthorization := types.NewAuthorization(assetModuleAddress)
-		newAuthorizationManager := types.NewAuthorization(managerAccAddress)
-		token.Authorized = append(token.Authorized, moduleAuthorization, newAuthorizationManager)
+		k.SetAuthorization(ctx, types.NewAuthorization(lowerCaseSymbol, assetModuleAddress))
+		k.SetAuthorization(ctx, types.NewAuthorization(lowerCaseSymbol, managerAccAddress))
 	}
 
-	k.SetToken(ctx, token)
-
-	k.bankKeeper.SetDenomMetaData(ctx, bank.Metadata{
-		Base: baseDenom, Symbol: lowerCaseSymbol, Name: lowerCaseName,
-		DenomUnits: []*bank.DenomUnit{{Denom: lowerCaseSymbol, Exponent: 18}, {Denom: baseDenom, Exponent: 0}},
-	})
+	k.setDenomMetadata(ctx, token)
+	if err := k.deployERC20Facade(ctx, token); err != nil {
+		return nil, err
+	}
 
 	// mint coins for the current module
-	// normalize into chains 10^18 denomination
+	// normalize into the token's 10^decimals denomination
 	totalInt, _ := math.NewIntFromString(msg.Total)
-	canonicalAmount := totalInt.Mul(realionetworktypes.PowerReduction)
-	coin := sdk.Coins{{Denom: baseDenom, Amount: canonicalAmount}}
+	coin := tokenCoins(token, totalInt)
 
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coin)
 
 	if err != nil {
 		panic(err)
 	}
+	k.syncERC20Supply(ctx, token)
 
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, managerAccAddress, coin)
 	if err != nil {
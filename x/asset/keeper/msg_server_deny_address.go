@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) DenyAddress(goCtx context.Context, msg *types.MsgDenyAddress) (*types.MsgDenyAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the compliance role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleCompliance) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	// the compliance data of a replica follows its issuing chain
+	if token.OriginChannel != "" {
+		return nil, sdkerrors.Wrapf(types.ErrReplicatedToken, "%s is issued over %s", msg.Symbol, token.OriginChannel)
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid address")
+	}
+
+	k.SetDeniedAddress(ctx, types.NewDeniedAddress(token.Symbol, accAddress.String()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddressDenied,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+	)
+
+	return &types.MsgDenyAddressResponse{}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) Distribute(goCtx context.Context, msg *types.MsgDistribute) (*types.MsgDistributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the issuer role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleIssuer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	amount, isValid := math.NewIntFromString(msg.Amount)
+	if !isValid || !amount.IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid distribution amount %s", msg.Amount)
+	}
+
+	distribution, err := k.distribute(ctx, token, signers[0], sdk.NewCoin(msg.Denom, amount), msg.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistribute,
+			sdk.NewAttribute(types.AttributeKeyDistribution, strconv.FormatUint(distribution.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount+msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyPolicy, msg.Policy.String()),
+			sdk.NewAttribute(types.AttributeKeySnapshot, strconv.FormatUint(distribution.SnapshotId, 10)),
+		),
+	)
+
+	return &types.MsgDistributeResponse{Id: distribution.Id}, nil
+}
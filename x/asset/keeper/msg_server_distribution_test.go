@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/testutil"
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestDistribution() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder1 := suite.testUser2Address
+	holder2 := suite.testUser3Address
+
+	// endBlock runs the distribution and snapshot steps of the EndBlocker
+	endBlock := func() {
+		suite.app.AssetKeeper.PayoutDistributions(suite.ctx)
+		suite.app.AssetKeeper.AllocateDistributions(suite.ctx)
+		suite.app.AssetKeeper.RecordSnapshotBalances(suite.ctx)
+	}
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "1000", AuthorizationRequired: true})
+	suite.Require().NoError(err)
+	for _, holder := range []string{holder1, holder2} {
+		_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager, Symbol: "RST", Address: holder})
+		suite.Require().NoError(err)
+	}
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder1, Amount: "200"})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder2, Amount: "100"})
+	suite.Require().NoError(err)
+
+	payout := sdk.NewCoins(sdk.NewCoin("ario", sdk.NewInt(200)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, payout))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.testUser1Acc, payout))
+
+	// only an issuer of the token can distribute
+	_, err = srv.Distribute(wctx, &types.MsgDistribute{Distributor: holder1, Symbol: "RST", Denom: "ario", Amount: "100"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the manager is left out of its own distribution, and the rounding
+	// remainder goes back to it once the shares are allocated
+	res, err := srv.Distribute(wctx, &types.MsgDistribute{Distributor: manager, Symbol: "RST", Denom: "ario", Amount: "100"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.Id)
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser1Acc, "ario").Amount)
+	endBlock()
+	suite.Require().Equal(sdk.NewInt(101), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser1Acc, "ario").Amount)
+
+	claims, err := suite.queryClient.PendingClaims(wctx, &types.QueryPendingClaimsRequest{Address: holder1})
+	suite.Require().NoError(err)
+	suite.Require().Len(claims.Claims, 1)
+	suite.Require().Equal("200", claims.Claims[0].Balance)
+	suite.Require().Equal("66", claims.Claims[0].Amount)
+
+	// the snapshot does not move with later transfers
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: holder1, To: holder2, Amount: "200"})
+	suite.Require().NoError(err)
+
+	_, err = srv.ClaimDistribution(wctx, &types.MsgClaimDistribution{Holder: holder1, DistributionId: 0})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(66), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser2Acc, "ario").Amount)
+	_, err = srv.ClaimDistribution(wctx, &types.MsgClaimDistribution{Holder: holder1, DistributionId: 0})
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	// an unauthorized holder keeps its share under the hold policy
+	_, err = srv.UnAuthorizeAddress(wctx, &types.MsgUnAuthorizeAddress{Manager: manager, Symbol: "RST", Address: holder2})
+	suite.Require().NoError(err)
+	_, err = srv.ClaimDistribution(wctx, &types.MsgClaimDistribution{Holder: holder2, DistributionId: 0})
+	suite.Require().ErrorIs(err, types.ErrHolderNotEligible)
+
+	suite.app.AssetKeeper.PayoutDistributions(suite.ctx)
+	claim, found := suite.app.AssetKeeper.GetDistributionClaim(suite.ctx, holder2, 0)
+	suite.Require().True(found)
+	suite.Require().True(claim.Held)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser3Acc, "ario").IsZero())
+
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager, Symbol: "RST", Address: holder2})
+	suite.Require().NoError(err)
+	_, err = srv.ClaimDistribution(wctx, &types.MsgClaimDistribution{Holder: holder2, DistributionId: 0})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(33), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser3Acc, "ario").Amount)
+
+	// the distribution is removed once every claim is settled
+	_, err = suite.queryClient.Distribution(wctx, &types.QueryDistributionRequest{Id: 0})
+	suite.Require().Error(err)
+
+	// under the forfeit policy, the EndBlocker pays eligible holders and
+	// returns the share of frozen ones to the distributor
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder1, Amount: "100"})
+	suite.Require().NoError(err)
+	_, err = srv.FreezeAddress(wctx, &types.MsgFreezeAddress{Manager: manager, Symbol: "RST", Address: holder2})
+	suite.Require().NoError(err)
+	res, err = srv.Distribute(wctx, &types.MsgDistribute{Distributor: manager, Symbol: "RST", Denom: "ario", Amount: "100", Policy: types.DistributionPolicyForfeit})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Id)
+	endBlock()
+	suite.Require().Equal(sdk.NewInt(1), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser1Acc, "ario").Amount)
+
+	suite.app.AssetKeeper.PayoutDistributions(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(66+25), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser2Acc, "ario").Amount)
+	suite.Require().Equal(sdk.NewInt(33), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser3Acc, "ario").Amount)
+	suite.Require().Equal(sdk.NewInt(1+75), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser1Acc, "ario").Amount)
+	suite.Require().Empty(suite.app.AssetKeeper.GetAllDistribution(suite.ctx))
+	suite.Require().Empty(suite.app.AssetKeeper.GetAllDistributionClaim(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestDistributionAllocation() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	manager := suite.testUser1Address
+
+	// endBlock runs the distribution and snapshot steps of the EndBlocker and
+	// moves to the next block
+	endBlock := func() {
+		suite.app.AssetKeeper.PayoutDistributions(suite.ctx)
+		suite.app.AssetKeeper.AllocateDistributions(suite.ctx)
+		suite.app.AssetKeeper.RecordSnapshotBalances(suite.ctx)
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	}
+	paid := func(address string) sdk.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(address), "ario").Amount
+	}
+
+	_, err := srv.CreateToken(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "10000", Decimals: "0"})
+	suite.Require().NoError(err)
+
+	// more holders than the EndBlocker allocates in a block, in the order of the holder store
+	holders := make([]string, types.MaxDistributionAllocationsPerBlock+20)
+	for i := range holders {
+		holders[i] = testutil.GenAddress().String()
+		_, err = srv.TransferToken(sdk.WrapSDKContext(suite.ctx), &types.MsgTransferToken{Symbol: "RST", From: manager, To: holders[i], Amount: "10"})
+		suite.Require().NoError(err)
+	}
+	sort.Strings(holders)
+
+	payout := sdk.NewCoins(sdk.NewCoin("ario", sdk.NewInt(1200)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, payout))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.testUser1Acc, payout))
+
+	// the distribution only escrows the amount, the EndBlocker allocates the
+	// shares in batches
+	_, err = srv.Distribute(sdk.WrapSDKContext(suite.ctx), &types.MsgDistribute{Distributor: manager, Symbol: "RST", Denom: "ario", Amount: "1200"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.AssetKeeper.GetAllDistributionClaim(suite.ctx))
+	endBlock()
+	// the distributor is visited with the holders but shares nothing
+	allocated := types.MaxDistributionAllocationsPerBlock
+	if manager < holders[allocated-1] {
+		allocated--
+	}
+	suite.Require().Len(suite.app.AssetKeeper.GetAllDistributionClaim(suite.ctx), allocated)
+
+	// a holder the EndBlocker did not reach yet sees and claims its share
+	claimer := holders[len(holders)-2]
+	claims, err := suite.queryClient.PendingClaims(sdk.WrapSDKContext(suite.ctx), &types.QueryPendingClaimsRequest{Address: claimer})
+	suite.Require().NoError(err)
+	suite.Require().Len(claims.Claims, 1)
+	suite.Require().Equal("10", claims.Claims[0].Amount)
+	_, err = srv.ClaimDistribution(sdk.WrapSDKContext(suite.ctx), &types.MsgClaimDistribution{Holder: claimer, DistributionId: 0})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(10), paid(claimer))
+	_, err = srv.ClaimDistribution(sdk.WrapSDKContext(suite.ctx), &types.MsgClaimDistribution{Holder: claimer, DistributionId: 0})
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+
+	// and another one leaves the holders before it is reached
+	leaver := holders[len(holders)-1]
+	_, err = srv.TransferToken(sdk.WrapSDKContext(suite.ctx), &types.MsgTransferToken{Symbol: "RST", From: leaver, To: manager, Amount: "10"})
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", sdk.MustAccAddressFromBech32(leaver)))
+
+	// every holder of the snapshot is paid its share once, over the next blocks
+	endBlock()
+	endBlock()
+	for _, holder := range holders {
+		suite.Require().Equal(sdk.NewInt(10), paid(holder), holder)
+	}
+	suite.Require().True(paid(manager).IsZero())
+	suite.Require().Empty(suite.app.AssetKeeper.GetAllDistribution(suite.ctx))
+	suite.Require().Empty(suite.app.AssetKeeper.GetAllDistributionClaim(suite.ctx))
+	suite.Require().Empty(suite.app.AssetKeeper.GetAllSettledDistributionClaim(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestPayoutDistributionsSkipsBrokenClaims() {
+	suite.SetupTest()
+
+	// a queued claim whose distribution is gone is held instead of halting the chain
+	claim := types.DistributionClaim{DistributionId: 7, Holder: suite.testUser2Address, Balance: "10", Amount: "10"}
+	suite.app.AssetKeeper.SetDistributionClaim(suite.ctx, claim)
+	suite.Require().NotPanics(func() {
+		suite.app.AssetKeeper.PayoutDistributions(suite.ctx)
+	})
+	stored, found := suite.app.AssetKeeper.GetDistributionClaim(suite.ctx, suite.testUser2Address, 7)
+	suite.Require().True(found)
+	suite.Require().True(stored.Held)
+}
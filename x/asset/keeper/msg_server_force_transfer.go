@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the compliance role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleCompliance) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	// the balances of a replica follow its issuing chain
+	if token.OriginChannel != "" {
+		return nil, sdkerrors.Wrapf(types.ErrReplicatedToken, "%s is issued over %s", msg.Symbol, token.OriginChannel)
+	}
+
+	if !token.ClawbackEnabled {
+		return nil, sdkerrors.Wrapf(types.ErrClawbackDisabled, "%s", msg.Symbol)
+	}
+
+	amount, ok := math.NewIntFromString(msg.Amount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin amount %s", msg.Amount)
+	}
+
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.From)
+	toAddress, _ := sdk.AccAddressFromBech32(msg.To)
+	// module and ICS-20 escrow accounts keep the books of settlements,
+	// redemptions, distributions and channels, which a forced transfer would break
+	for _, address := range []sdk.AccAddress{fromAddress, toAddress} {
+		if k.AllowAddr(address) || k.IsTransferEscrow(ctx, address) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "cannot force a transfer with escrow account %s", address)
+		}
+	}
+	baseDenom := fmt.Sprintf("a%s", strings.ToLower(msg.Symbol))
+	coin := sdk.Coins{{Denom: baseDenom, Amount: amount}}
+
+	// the asset send restriction is skipped, a forced transfer overrides authorization, freezes and pauses
+	if err := k.bankKeeper.SendCoins(withRestrictionBypass(ctx), fromAddress, toAddress, coin); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForceTransfer,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyFrom, msg.From),
+			sdk.NewAttribute(types.AttributeKeyTo, msg.To),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		),
+	)
+
+	return &types.MsgForceTransferResponse{}, nil
+}
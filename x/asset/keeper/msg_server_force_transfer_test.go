@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestForceTransfer() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+	recipient := suite.testUser3Address
+
+	// clawback must be chosen at creation
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "1000"})
+	suite.Require().NoError(err)
+	_, err = srv.ForceTransfer(wctx, &types.MsgForceTransfer{Manager: manager, Symbol: "RST", From: manager, To: holder, Amount: "1", Reason: "test"})
+	suite.Require().ErrorIs(err, types.ErrClawbackDisabled)
+
+	_, err = srv.CreateToken(wctx, &types.MsgCreateToken{
+		Manager: manager, Symbol: "CLW", Total: "1000",
+		AuthorizationRequired: true, ClawbackEnabled: true,
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.Token(wctx, &types.QueryTokenRequest{Symbol: "CLW"})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Token.ClawbackEnabled)
+
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: manager, Symbol: "CLW", Address: holder})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "CLW", From: manager, To: holder, Amount: "100"})
+	suite.Require().NoError(err)
+	_, err = srv.FreezeAddress(wctx, &types.MsgFreezeAddress{Manager: manager, Symbol: "CLW", Address: holder})
+	suite.Require().NoError(err)
+
+	// only the manager or a compliance officer can force a transfer
+	_, err = srv.ForceTransfer(wctx, &types.MsgForceTransfer{Manager: holder, Symbol: "CLW", From: holder, To: recipient, Amount: "40", Reason: "test"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the frozen holder's tokens move to an unauthorized recipient
+	_, err = srv.ForceTransfer(wctx, &types.MsgForceTransfer{Manager: manager, Symbol: "CLW", From: holder, To: recipient, Amount: "40", Reason: "court order 42"})
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewInt(60), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser2Acc, "aclw").Amount)
+	suite.Require().Equal(sdk.NewInt(40), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser3Acc, "aclw").Amount)
+
+	found := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeForceTransfer {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyReason {
+				suite.Require().Equal("court order 42", string(attr.Value))
+				found = true
+			}
+		}
+	}
+	suite.Require().True(found)
+
+	// the restriction still applies to regular sends afterwards
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser3Acc, suite.testUser1Acc, sdk.NewCoins(sdk.NewCoin("aclw", sdk.NewInt(10))))
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+
+	// escrowed units cannot be forced out of or into module and ICS-20 escrow accounts
+	escrowed := sdk.NewCoins(sdk.NewCoin("aclw", sdk.NewInt(10)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, suite.testUser1Acc, types.ModuleName, escrowed))
+	module := authtypes.NewModuleAddress(types.ModuleName).String()
+	_, err = srv.ForceTransfer(wctx, &types.MsgForceTransfer{Manager: manager, Symbol: "CLW", From: module, To: recipient, Amount: "10", Reason: "test"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidAddress)
+	_, err = srv.ForceTransfer(wctx, &types.MsgForceTransfer{Manager: manager, Symbol: "CLW", From: holder, To: module, Amount: "10", Reason: "test"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidAddress)
+
+	suite.app.AssetKeeper.SetTransferEscrow(suite.ctx, "channel-0")
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0").String()
+	_, err = srv.ForceTransfer(wctx, &types.MsgForceTransfer{Manager: manager, Symbol: "CLW", From: holder, To: escrow, Amount: "10", Reason: "test"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidAddress)
+	suite.Require().Equal(sdk.NewInt(10), suite.app.BankKeeper.GetBalance(suite.ctx, authtypes.NewModuleAddress(types.ModuleName), "aclw").Amount)
+}
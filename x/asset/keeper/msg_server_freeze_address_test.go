@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestFreezeAddress() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+
+	// freezing works on permissionless tokens
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "100"})
+	suite.Require().NoError(err)
+
+	// only the manager or a freezer can freeze
+	_, err = srv.FreezeAddress(wctx, &types.MsgFreezeAddress{Manager: holder, Symbol: "RST", Address: holder})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.FreezeAddress(wctx, &types.MsgFreezeAddress{Manager: manager, Symbol: "RST", Address: holder})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.IsFrozen(wctx, types.NewQueryIsFrozenRequest("RST", holder))
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsFrozen)
+
+	// a frozen holder cannot send, neither through the module nor through bank
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: holder, To: manager, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser2Acc, suite.testUser1Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(10))))
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+
+	// but can still receive
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "10"})
+	suite.Require().NoError(err)
+
+	_, err = srv.UnfreezeAddress(wctx, &types.MsgUnfreezeAddress{Manager: manager, Symbol: "RST", Address: holder})
+	suite.Require().NoError(err)
+
+	frozen, err := suite.queryClient.FrozenAddresses(wctx, types.NewQueryFrozenAddressesRequest("RST"))
+	suite.Require().NoError(err)
+	suite.Require().Empty(frozen.Frozen)
+
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: holder, To: manager, Amount: "10"})
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestHolderLimits() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+	other := suite.testUser3Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager, Name: "realio security token",
+		Symbol: "RST", Total: "1000", Decimals: "0",
+		MaxHolders: 2, MaxBalancePerHolder: "100",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), suite.app.AssetKeeper.GetHolderCount(suite.ctx, "RST"))
+
+	// the manager is exempt from the balance cap
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "101"})
+	suite.Require().ErrorIs(err, types.ErrMaxBalanceExceeded)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "100"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), suite.app.AssetKeeper.GetHolderCount(suite.ctx, "RST"))
+
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: other, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrMaxHoldersExceeded)
+	_, err = srv.MintToken(wctx, &types.MsgMintToken{Manager: manager, Symbol: "RST", To: other, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrMaxHoldersExceeded)
+
+	// a holder handing over its whole balance makes room for the receiver
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: holder, To: other, Amount: "100"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), suite.app.AssetKeeper.GetHolderCount(suite.ctx, "RST"))
+	suite.Require().False(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", suite.testUser2Acc))
+	suite.Require().True(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", suite.testUser3Acc))
+
+	// multi sends debit the sender before the restriction runs
+	coins := sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(100)))
+	err = suite.app.BankKeeper.InputOutputCoins(suite.ctx,
+		banktypes.NewInput(suite.testUser3Acc, coins),
+		[]banktypes.Output{banktypes.NewOutput(suite.testUser2Acc, coins)},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), suite.app.AssetKeeper.GetHolderCount(suite.ctx, "RST"))
+	suite.Require().False(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", suite.testUser3Acc))
+
+	res, err := suite.queryClient.HolderCount(wctx, types.NewQueryHolderCountRequest("RST"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Count)
+
+	_, err = srv.UpdateHolderLimits(wctx, &types.MsgUpdateHolderLimits{Manager: holder, Symbol: "RST"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UpdateHolderLimits(wctx, &types.MsgUpdateHolderLimits{Manager: manager, Symbol: "RST"})
+	suite.Require().NoError(err)
+
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: other, Amount: "500"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), suite.app.AssetKeeper.GetHolderCount(suite.ctx, "RST"))
+}
+
+func (suite *KeeperTestSuite) TestHolderLimitsMultiSend() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	bankSrv := bankkeeper.NewMsgServerImpl(suite.app.BankKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "1000", Decimals: "0", MaxHolders: 2})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "20"})
+	suite.Require().NoError(err)
+
+	// the input is debited before the outputs fail, so each multi-send only
+	// writes its changes when it succeeds, like a tx
+	multiSend := func(outputs ...banktypes.Output) error {
+		total := sdk.NewCoins()
+		for _, output := range outputs {
+			total = total.Add(output.Coins...)
+		}
+		cacheCtx, write := suite.ctx.CacheContext()
+		msg := banktypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(suite.testUser2Acc, total)}, outputs)
+		if _, err := bankSrv.MultiSend(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+			return err
+		}
+		write()
+		return nil
+	}
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(amount)))
+	}
+
+	// a partial multi-send keeps the sender a holder
+	err = multiSend(banktypes.NewOutput(suite.testUser3Acc, coins(10)))
+	suite.Require().ErrorIs(err, types.ErrMaxHoldersExceeded)
+	err = multiSend(banktypes.NewOutput(suite.testUser1Acc, coins(10)))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", suite.testUser2Acc))
+	suite.Require().Equal(uint64(2), suite.app.AssetKeeper.GetHolderCount(suite.ctx, "RST"))
+
+	// outputs back to the sender keep it a holder as well
+	err = multiSend(banktypes.NewOutput(suite.testUser1Acc, coins(5)), banktypes.NewOutput(suite.testUser2Acc, coins(5)))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", suite.testUser2Acc))
+	suite.Require().Equal(uint64(2), suite.app.AssetKeeper.GetHolderCount(suite.ctx, "RST"))
+
+	// the whole balance split across outputs hands the slot over
+	err = multiSend(banktypes.NewOutput(suite.testUser1Acc, coins(2)), banktypes.NewOutput(suite.testUser3Acc, coins(3)))
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", suite.testUser2Acc))
+	suite.Require().True(suite.app.AssetKeeper.IsHolder(suite.ctx, "RST", suite.testUser3Acc))
+	suite.Require().Equal(uint64(2), suite.app.AssetKeeper.GetHolderCount(suite.ctx, "RST"))
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestManagerTransfer() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	newManager := suite.testUser2Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000", AuthorizationRequired: true,
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	// only the current manager can propose
+	_, err = srv.ProposeManager(wctx, &types.MsgProposeManager{Manager: newManager, Symbol: "RST", NewManager: newManager})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.ProposeManager(wctx, &types.MsgProposeManager{Manager: manager, Symbol: "RST", NewManager: newManager})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.Token(wctx, types.NewQueryTokenRequest("RST"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(manager, res.Token.Manager)
+	suite.Require().Equal(newManager, res.Token.PendingManager)
+
+	// only the pending manager can accept
+	_, err = srv.AcceptManager(wctx, &types.MsgAcceptManager{NewManager: suite.testUser3Address, Symbol: "RST"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.AcceptManager(wctx, &types.MsgAcceptManager{NewManager: newManager, Symbol: "RST"})
+	suite.Require().NoError(err)
+
+	rst, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "rst")
+	suite.Require().Equal(newManager, rst.Manager)
+	suite.Require().Empty(rst.PendingManager)
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, "rst", suite.testUser2Acc))
+
+	// the previous manager lost control, the new one gained it
+	authMsg := &types.MsgAuthorizeAddress{Manager: manager, Symbol: "RST", Address: suite.testUser3Address}
+	_, err = srv.AuthorizeAddress(wctx, authMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	authMsg.Manager = newManager
+	_, err = srv.AuthorizeAddress(wctx, authMsg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestCancelManagerProposal() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	newManager := suite.testUser2Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	_, err = srv.CancelManagerProposal(wctx, &types.MsgCancelManagerProposal{Manager: manager, Symbol: "RST"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.ProposeManager(wctx, &types.MsgProposeManager{Manager: manager, Symbol: "RST", NewManager: newManager})
+	suite.Require().NoError(err)
+
+	_, err = srv.CancelManagerProposal(wctx, &types.MsgCancelManagerProposal{Manager: manager, Symbol: "RST"})
+	suite.Require().NoError(err)
+
+	_, err = srv.AcceptManager(wctx, &types.MsgAcceptManager{NewManager: newManager, Symbol: "RST"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	rst, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "rst")
+	suite.Require().Equal(manager, rst.Manager)
+}
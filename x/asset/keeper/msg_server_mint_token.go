@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) MintToken(goCtx context.Context, msg *types.MsgMintToken) (*types.MsgMintTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the issuer role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleIssuer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	// the supply of a replica is only issued on its issuing chain
+	if token.OriginChannel != "" {
+		return nil, sdkerrors.Wrapf(types.ErrReplicatedToken, "%s is issued over %s", msg.Symbol, token.OriginChannel)
+	}
+
+	toAddress, err := sdk.AccAddressFromBech32(msg.To)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid to address")
+	}
+
+	// newly issued units can only go to addresses allowed to hold the token
+	switch token.EffectiveRestrictionMode() {
+	case types.RestrictionModeAllowlist:
+		if !k.IsAddressAuthorizedToSend(ctx, msg.Symbol, toAddress) {
+			return nil, sdkerrors.Wrapf(types.ErrNotAuthorized, "%s is not authorized to receive %s", msg.To, msg.Symbol)
+		}
+	case types.RestrictionModeDenylist:
+		if k.IsAddressDenied(ctx, msg.Symbol, toAddress) {
+			return nil, sdkerrors.Wrapf(types.ErrAddressDenied, "%s is denied for %s", msg.To, msg.Symbol)
+		}
+	}
+
+	amount, isValid := math.NewIntFromString(msg.Amount)
+	if !isValid || !amount.IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid mint amount %s", msg.Amount)
+	}
+
+	total, isValid := math.NewIntFromString(token.Total)
+	if !isValid {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token total %s", token.Total)
+	}
+
+	newTotal := total.Add(amount)
+	if token.MaxSupply != "" {
+		maxSupply, _ := math.NewIntFromString(token.MaxSupply)
+		if newTotal.GT(maxSupply) {
+			return nil, sdkerrors.Wrapf(types.ErrMaxSupplyExceeded, "minting %s %s would exceed max supply %s", msg.Amount, msg.Symbol, token.MaxSupply)
+		}
+	}
+
+	coin := tokenCoins(token, amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coin); err != nil {
+		return nil, err
+	}
+	k.syncERC20Supply(ctx, token)
+	k.trackSnapshotSupply(ctx, token, coin.AmountOf(tokenDenom(token)))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddress, coin); err != nil {
+		return nil, err
+	}
+
+	token.Total = newTotal.String()
+	k.SetToken(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenMinted,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.To),
+		),
+	)
+
+	return &types.MsgMintTokenResponse{}, nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	realionetworktypes "github.com/realiotech/realio-network/types"
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestMintToken() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	mintMsg := &types.MsgMintToken{
+		Manager: manager,
+		Symbol:  "RST", To: suite.testUser2Address, Amount: "500",
+	}
+	_, err = srv.MintToken(wctx, mintMsg)
+	suite.Require().NoError(err)
+
+	rst, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "rst")
+	suite.Require().Equal("1500", rst.Total)
+
+	expected := math.NewInt(500).Mul(realionetworktypes.PowerReduction)
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser2Acc, "arst")
+	suite.Require().Equal(expected, balance.Amount)
+
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, "arst")
+	suite.Require().Equal(math.NewInt(1500).Mul(realionetworktypes.PowerReduction), supply.Amount)
+}
+
+func (suite *KeeperTestSuite) TestMintTokenSenderUnauthorized() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	t1 := &types.MsgCreateToken{
+		Manager: suite.testUser1Address,
+		Symbol:  "RST", Total: "1000",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	mintMsg := &types.MsgMintToken{
+		Manager: suite.testUser2Address,
+		Symbol:  "RST", To: suite.testUser2Address, Amount: "500",
+	}
+	_, err = srv.MintToken(wctx, mintMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestMintTokenReceiverNotAuthorized() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000", AuthorizationRequired: true,
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	mintMsg := &types.MsgMintToken{
+		Manager: manager,
+		Symbol:  "RST", To: suite.testUser2Address, Amount: "500",
+	}
+	_, err = srv.MintToken(wctx, mintMsg)
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{
+		Manager: manager,
+		Symbol:  "RST", Address: suite.testUser2Address,
+	})
+	suite.Require().NoError(err)
+
+	_, err = srv.MintToken(wctx, mintMsg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMintTokenMaxSupply() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000", MaxSupply: "1200",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	mintMsg := &types.MsgMintToken{
+		Manager: manager,
+		Symbol:  "RST", To: manager, Amount: "201",
+	}
+	_, err = srv.MintToken(wctx, mintMsg)
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	mintMsg.Amount = "200"
+	_, err = srv.MintToken(wctx, mintMsg)
+	suite.Require().NoError(err)
+
+	rst, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "rst")
+	suite.Require().Equal("1200", rst.Total)
+}
+
+func (suite *KeeperTestSuite) TestCreateTokenTotalExceedsMaxSupply() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+
+	t1 := &types.MsgCreateToken{
+		Manager: suite.testUser1Address,
+		Symbol:  "RST", Total: "1000", MaxSupply: "999",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+}
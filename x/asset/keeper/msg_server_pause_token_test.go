@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestPauseToken() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "100"})
+	suite.Require().NoError(err)
+
+	// only the manager or a freezer can pause
+	_, err = srv.PauseToken(wctx, &types.MsgPauseToken{Manager: holder, Symbol: "RST"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.UnpauseToken(wctx, &types.MsgUnpauseToken{Manager: manager, Symbol: "RST"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.PauseToken(wctx, &types.MsgPauseToken{Manager: manager, Symbol: "RST"})
+	suite.Require().NoError(err)
+
+	_, err = srv.PauseToken(wctx, &types.MsgPauseToken{Manager: manager, Symbol: "RST"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	res, err := suite.queryClient.Token(wctx, &types.QueryTokenRequest{Symbol: "RST"})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Token.Paused)
+
+	// nobody can move the token, not even the manager
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrTokenPaused)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser2Acc, suite.testUser1Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(10))))
+	suite.Require().ErrorIs(err, types.ErrTokenPaused)
+
+	// moves out of the module account are still allowed
+	_, err = srv.MintToken(wctx, &types.MsgMintToken{Manager: manager, Symbol: "RST", To: holder, Amount: "10"})
+	suite.Require().NoError(err)
+
+	_, err = srv.UnpauseToken(wctx, &types.MsgUnpauseToken{Manager: manager, Symbol: "RST"})
+	suite.Require().NoError(err)
+
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: holder, To: manager, Amount: "10"})
+	suite.Require().NoError(err)
+}
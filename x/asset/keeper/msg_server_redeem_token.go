@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) RedeemToken(goCtx context.Context, msg *types.MsgRedeemToken) (*types.MsgRedeemTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the issuer role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleIssuer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	redemption, isFound := k.GetRedemptionRequest(ctx, msg.Symbol, msg.Holder)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "no redemption request for %s from %s", msg.Symbol, msg.Holder)
+	}
+
+	amount, _ := math.NewIntFromString(redemption.Amount)
+	if err := k.burnTokens(ctx, token, amount); err != nil {
+		return nil, err
+	}
+
+	k.RemoveRedemptionRequest(ctx, msg.Symbol, msg.Holder)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenRedeemed,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, redemption.Amount),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Holder),
+		),
+	)
+
+	return &types.MsgRedeemTokenResponse{}, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) ReplicateToken(goCtx context.Context, msg *types.MsgReplicateToken) (*types.MsgReplicateTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the admin role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleAdmin) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	// only the issuing chain can replicate a token
+	if token.OriginChannel != "" {
+		return nil, sdkerrors.Wrapf(types.ErrReplicatedToken, "%s is replicated over %s", msg.Symbol, token.OriginChannel)
+	}
+
+	sequence, err := k.TransmitTokenDefinitionPacket(ctx, token, msg.Channel, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReplicateToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyChannel, msg.Channel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprint(sequence)),
+		),
+	)
+
+	return &types.MsgReplicateTokenResponse{}, nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgRequestRedemption) (*types.MsgRequestRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	holderAddress, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid holder address")
+	}
+
+	amount, isValid := math.NewIntFromString(msg.Amount)
+	if !isValid || !amount.IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid redemption amount %s", msg.Amount)
+	}
+
+	// escrow the units in the module account until the manager redeems them
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holderAddress, types.ModuleName, tokenCoins(token, amount)); err != nil {
+		return nil, err
+	}
+
+	// a new request is merged into the holder's pending one
+	redemption, isFound := k.GetRedemptionRequest(ctx, msg.Symbol, msg.Holder)
+	if isFound {
+		pending, _ := math.NewIntFromString(redemption.Amount)
+		amount = amount.Add(pending)
+	}
+
+	k.SetRedemptionRequest(ctx, types.NewRedemptionRequest(token.Symbol, msg.Holder, amount.String()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedemptionRequest,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Holder),
+		),
+	)
+
+	return &types.MsgRequestRedemptionResponse{}, nil
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestRestrictionModes() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager, Name: "realio security token",
+		Symbol: "RST", Total: "1000", RestrictionMode: types.RestrictionModeDenylist,
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	// anyone can receive a denylist token without an authorization
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "100"})
+	suite.Require().NoError(err)
+
+	_, err = srv.DenyAddress(wctx, &types.MsgDenyAddress{Manager: holder, Symbol: "RST", Address: holder})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.DenyAddress(wctx, &types.MsgDenyAddress{Manager: manager, Symbol: "RST", Address: holder})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.IsDenied(wctx, types.NewQueryIsDeniedRequest("RST", holder))
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsDenied)
+	denied, err := suite.queryClient.DeniedAddresses(wctx, types.NewQueryDeniedAddressesRequest("RST", nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DeniedAddress{types.NewDeniedAddress("rst", holder)}, denied.Denied)
+
+	// a denied address can neither send nor receive, through any path
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrAddressDenied)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: holder, To: manager, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrAddressDenied)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser2Acc, suite.testUser3Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(10))))
+	suite.Require().ErrorIs(err, types.ErrAddressDenied)
+	_, err = srv.MintToken(wctx, &types.MsgMintToken{Manager: manager, Symbol: "RST", To: holder, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrAddressDenied)
+
+	_, err = srv.UndenyAddress(wctx, &types.MsgUndenyAddress{Manager: manager, Symbol: "RST", Address: holder})
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser2Acc, suite.testUser3Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(10))))
+	suite.Require().NoError(err)
+
+	// switching to allowlist requires authorizations again
+	_, err = srv.SetRestrictionMode(wctx, &types.MsgSetRestrictionMode{Manager: holder, Symbol: "RST", Mode: types.RestrictionModeAllowlist})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SetRestrictionMode(wctx, &types.MsgSetRestrictionMode{Manager: manager, Symbol: "RST", Mode: types.RestrictionModeAllowlist})
+	suite.Require().NoError(err)
+	token, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "RST")
+	suite.Require().True(token.AuthorizationRequired)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+	suite.Require().ErrorContains(err, holder+" is not authorized")
+
+	// UpdateToken toggles between allowlist and open
+	_, err = srv.UpdateToken(wctx, &types.MsgUpdateToken{Manager: manager, Symbol: "RST", AuthorizationRequired: false})
+	suite.Require().NoError(err)
+	token, _ = suite.app.AssetKeeper.GetToken(suite.ctx, "RST")
+	suite.Require().Equal(types.RestrictionModeOpen, token.EffectiveRestrictionMode())
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "10"})
+	suite.Require().NoError(err)
+
+	// denied addresses only matter in denylist mode
+	_, err = srv.DenyAddress(wctx, &types.MsgDenyAddress{Manager: manager, Symbol: "RST", Address: holder})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "10"})
+	suite.Require().NoError(err)
+	_, err = srv.SetRestrictionMode(wctx, &types.MsgSetRestrictionMode{Manager: manager, Symbol: "RST", Mode: types.RestrictionModeDenylist})
+	suite.Require().NoError(err)
+	_, err = srv.UpdateToken(wctx, &types.MsgUpdateToken{Manager: manager, Symbol: "RST", AuthorizationRequired: false})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "10"})
+	suite.Require().ErrorIs(err, types.ErrAddressDenied)
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestRoleCompliance() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	officer := suite.testUser2Address
+	investor := suite.testUser3Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000", AuthorizationRequired: true,
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	authMsg := &types.MsgAuthorizeAddress{Manager: officer, Symbol: "RST", Address: investor}
+	_, err = srv.AuthorizeAddress(wctx, authMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.GrantRole(wctx, types.NewMsgGrantRole(manager, "RST", officer, types.RoleCompliance))
+	suite.Require().NoError(err)
+
+	// granting twice fails
+	_, err = srv.GrantRole(wctx, types.NewMsgGrantRole(manager, "RST", officer, types.RoleCompliance))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.AuthorizeAddress(wctx, authMsg)
+	suite.Require().NoError(err)
+	_, err = srv.UnAuthorizeAddress(wctx, &types.MsgUnAuthorizeAddress{Manager: officer, Symbol: "RST", Address: investor})
+	suite.Require().NoError(err)
+
+	// the compliance officer cannot mint nor manage roles
+	_, err = srv.MintToken(wctx, &types.MsgMintToken{Manager: officer, Symbol: "RST", To: manager, Amount: "1"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.GrantRole(wctx, types.NewMsgGrantRole(officer, "RST", officer, types.RoleIssuer))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	res, err := suite.queryClient.Roles(wctx, types.NewQueryRolesRequest("RST"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(manager, res.Manager)
+	suite.Require().Equal([]types.RoleAssignment{types.NewRoleAssignment("rst", officer, types.RoleCompliance)}, res.Roles)
+
+	_, err = srv.RevokeRole(wctx, types.NewMsgRevokeRole(manager, "RST", officer, types.RoleCompliance))
+	suite.Require().NoError(err)
+
+	_, err = srv.AuthorizeAddress(wctx, authMsg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.RevokeRole(wctx, types.NewMsgRevokeRole(manager, "RST", officer, types.RoleCompliance))
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+}
+
+func (suite *KeeperTestSuite) TestRoleAdminAndIssuer() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	admin := suite.testUser2Address
+	issuer := suite.testUser3Address
+
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Symbol:  "RST", Total: "1000",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	_, err = srv.GrantRole(wctx, types.NewMsgGrantRole(manager, "RST", admin, types.RoleAdmin))
+	suite.Require().NoError(err)
+
+	// admins can update the token and delegate roles
+	_, err = srv.UpdateToken(wctx, &types.MsgUpdateToken{Manager: admin, Symbol: "RST", AuthorizationRequired: false})
+	suite.Require().NoError(err)
+	_, err = srv.GrantRole(wctx, types.NewMsgGrantRole(admin, "RST", issuer, types.RoleIssuer))
+	suite.Require().NoError(err)
+
+	_, err = srv.MintToken(wctx, &types.MsgMintToken{Manager: issuer, Symbol: "RST", To: issuer, Amount: "10"})
+	suite.Require().NoError(err)
+	_, err = srv.MintToken(wctx, &types.MsgMintToken{Manager: admin, Symbol: "RST", To: admin, Amount: "10"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
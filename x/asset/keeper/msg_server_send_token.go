@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) SendToken(goCtx context.Context, msg *types.MsgSendToken) (*types.MsgSendTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "token %s not found", msg.Symbol)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address")
+	}
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid receiver address")
+	}
+	amount, isValid := math.NewIntFromString(msg.Amount)
+	if !isValid {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin amount %s", msg.Amount)
+	}
+
+	sequence, err := k.TransmitTokenTransferPacket(ctx, token, sender, receiver, amount, msg.Channel, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSendToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyFrom, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyTo, msg.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount),
+			sdk.NewAttribute(types.AttributeKeyChannel, msg.Channel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprint(sequence)),
+		),
+	)
+
+	return &types.MsgSendTokenResponse{}, nil
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) SetComplianceRules(goCtx context.Context, msg *types.MsgSetComplianceRules) (*types.MsgSetComplianceRulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the admin role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleAdmin) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	ids := make([]string, 0, len(msg.Rules))
+	for _, config := range msg.Rules {
+		if types.IsMandatoryComplianceRule(config.Id) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "compliance rule %s is always checked", config.Id)
+		}
+		rule, found := k.GetComplianceRule(config.Id)
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrUnknownComplianceRule, "rule %s", config.Id)
+		}
+		if err := rule.ValidateParams(config.Params); err != nil {
+			return nil, sdkerrors.Wrapf(err, "rule %s", config.Id)
+		}
+		ids = append(ids, config.Id)
+	}
+
+	token.ComplianceRules = msg.Rules
+	k.SetToken(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeComplianceRulesSet,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyRules, strings.Join(ids, ",")),
+		),
+	)
+
+	return &types.MsgSetComplianceRulesResponse{}, nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) SetRestrictionMode(goCtx context.Context, msg *types.MsgSetRestrictionMode) (*types.MsgSetRestrictionModeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the admin role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleAdmin) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	// like at creation, the module account and the manager of an allowlist
+	// token must be able to hold it
+	if msg.Mode == types.RestrictionModeAllowlist {
+		managerAccAddress, err := sdk.AccAddressFromBech32(token.Manager)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid manager address")
+		}
+		k.SetAuthorization(ctx, types.NewAuthorization(token.Symbol, k.ak.GetModuleAddress(types.ModuleName)))
+		k.SetAuthorization(ctx, types.NewAuthorization(token.Symbol, managerAccAddress))
+	}
+
+	token.SetRestrictionMode(msg.Mode)
+	k.SetToken(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRestrictionModeSet,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyMode, msg.Mode.String()),
+		),
+	)
+
+	return &types.MsgSetRestrictionModeResponse{}, nil
+}
@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestSettlement() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	seller := suite.testUser1Address
+	buyer := suite.testUser2Address
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+
+	balance := func(address sdk.AccAddress, denom string) sdk.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, address, denom).Amount
+	}
+
+	// the seller issues the delivered token, the buyer the allowlist payment token
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: seller, Symbol: "RST", Total: "1000", Decimals: "0"})
+	suite.Require().NoError(err)
+	_, err = srv.CreateToken(wctx, &types.MsgCreateToken{Manager: buyer, Symbol: "BTF", Total: "1000", Decimals: "0", AuthorizationRequired: true})
+	suite.Require().NoError(err)
+
+	msg := &types.MsgCreateSettlement{
+		Creator:         seller,
+		Counterparty:    buyer,
+		CreatorLeg:      types.SettlementLeg{Symbol: "RST", Amount: "100"},
+		CounterpartyLeg: types.SettlementLeg{Symbol: "BTF", Amount: "250"},
+		Expiry:          expiry,
+	}
+
+	// the payment leg is checked before anything is escrowed
+	_, err = srv.CreateSettlement(wctx, msg)
+	suite.Require().ErrorIs(err, types.ErrNotAuthorized)
+	suite.Require().Equal(sdk.NewInt(1000), balance(suite.testUser1Acc, "arst"))
+
+	_, err = srv.AuthorizeAddress(wctx, &types.MsgAuthorizeAddress{Manager: buyer, Symbol: "BTF", Address: seller})
+	suite.Require().NoError(err)
+	res, err := srv.CreateSettlement(wctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(900), balance(suite.testUser1Acc, "arst"))
+
+	// escrowed units are outside of the holder balances a split rescales
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: seller, Symbol: "RST", Numerator: 2, Denominator: 1})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// only the counterparty can affirm
+	_, err = srv.AffirmSettlement(wctx, &types.MsgAffirmSettlement{Counterparty: suite.testUser3Address, Id: res.Id})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.AffirmSettlement(wctx, &types.MsgAffirmSettlement{Counterparty: buyer, Id: res.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), balance(suite.testUser2Acc, "arst"))
+	suite.Require().Equal(sdk.NewInt(750), balance(suite.testUser2Acc, "abtf"))
+	suite.Require().Equal(sdk.NewInt(250), balance(suite.testUser1Acc, "abtf"))
+	_, found := suite.app.AssetKeeper.GetSettlement(suite.ctx, res.Id)
+	suite.Require().False(found)
+
+	// a settlement that is not affirmed in time is refunded by the EndBlocker
+	res, err = srv.CreateSettlement(wctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(800), balance(suite.testUser1Acc, "arst"))
+
+	suite.ctx = suite.ctx.WithBlockTime(expiry)
+	_, err = srv.AffirmSettlement(sdk.WrapSDKContext(suite.ctx), &types.MsgAffirmSettlement{Counterparty: buyer, Id: res.Id})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	suite.app.AssetKeeper.ExpireSettlements(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(900), balance(suite.testUser1Acc, "arst"))
+	_, found = suite.app.AssetKeeper.GetSettlement(suite.ctx, res.Id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSettlementExpiryPerBlock() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	seller := suite.testUser1Address
+	buyer := suite.testUser2Address
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+
+	balance := func() sdk.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser1Acc, "arst").Amount
+	}
+	expired := func() (count int) {
+		for _, event := range suite.ctx.EventManager().Events() {
+			if event.Type == types.EventTypeSettlementExpired {
+				count++
+			}
+		}
+		return count
+	}
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: seller, Symbol: "RST", Total: "1000"})
+	suite.Require().NoError(err)
+	_, err = srv.CreateToken(wctx, &types.MsgCreateToken{Manager: buyer, Symbol: "BTF", Total: "1000"})
+	suite.Require().NoError(err)
+
+	// the legs are in whole units, like the amounts of the other token messages
+	msg := &types.MsgCreateSettlement{
+		Creator:         seller,
+		Counterparty:    buyer,
+		CreatorLeg:      types.SettlementLeg{Symbol: "RST", Amount: "1"},
+		CounterpartyLeg: types.SettlementLeg{Symbol: "BTF", Amount: "2"},
+		Expiry:          expiry,
+	}
+	for i := 0; i < types.MaxSettlementExpiriesPerBlock+1; i++ {
+		_, err = srv.CreateSettlement(wctx, msg)
+		suite.Require().NoError(err)
+	}
+	suite.Require().Equal(math.NewIntWithDecimal(1000-types.MaxSettlementExpiriesPerBlock-1, 18), balance())
+
+	// the queue is drained over as many blocks as it takes
+	suite.ctx = suite.ctx.WithBlockTime(expiry).WithEventManager(sdk.NewEventManager())
+	suite.app.AssetKeeper.ExpireSettlements(suite.ctx)
+	suite.Require().Equal(types.MaxSettlementExpiriesPerBlock, expired())
+	suite.Require().Len(suite.app.AssetKeeper.GetAllSettlement(suite.ctx), 1)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.app.AssetKeeper.ExpireSettlements(suite.ctx)
+	suite.Require().Equal(1, expired())
+	suite.Require().Empty(suite.app.AssetKeeper.GetAllSettlement(suite.ctx))
+	suite.Require().Equal(math.NewIntWithDecimal(1000, 18), balance())
+}
+
+func (suite *KeeperTestSuite) TestCancelSettlement() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	seller := suite.testUser1Address
+	buyer := suite.testUser2Address
+
+	balance := func() sdk.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser1Acc, "arst").Amount
+	}
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: seller, Symbol: "RST", Total: "1000", Decimals: "0"})
+	suite.Require().NoError(err)
+	_, err = srv.CreateToken(wctx, &types.MsgCreateToken{Manager: buyer, Symbol: "BTF", Total: "1000", Decimals: "0"})
+	suite.Require().NoError(err)
+
+	// a mistaken far future expiry does not lock the leg of the creator
+	res, err := srv.CreateSettlement(wctx, &types.MsgCreateSettlement{
+		Creator:         seller,
+		Counterparty:    buyer,
+		CreatorLeg:      types.SettlementLeg{Symbol: "RST", Amount: "100"},
+		CounterpartyLeg: types.SettlementLeg{Symbol: "BTF", Amount: "250"},
+		Expiry:          suite.ctx.BlockTime().AddDate(100, 0, 0),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(900), balance())
+
+	// only the creator can cancel, even while the token is paused
+	_, err = srv.CancelSettlement(wctx, &types.MsgCancelSettlement{Creator: buyer, Id: res.Id})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = srv.PauseToken(wctx, &types.MsgPauseToken{Manager: seller, Symbol: "RST"})
+	suite.Require().NoError(err)
+	_, err = srv.CancelSettlement(wctx, &types.MsgCancelSettlement{Creator: seller, Id: res.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), balance())
+	suite.Require().Empty(suite.app.AssetKeeper.GetAllSettlement(suite.ctx))
+
+	// a cancelled settlement can neither be affirmed nor cancelled again
+	_, err = srv.AffirmSettlement(wctx, &types.MsgAffirmSettlement{Counterparty: buyer, Id: res.Id})
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.CancelSettlement(wctx, &types.MsgCancelSettlement{Creator: seller, Id: res.Id})
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestSnapshot() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	manager := suite.testUser1Address
+	holder1 := suite.testUser2Address
+	holder2 := suite.testUser3Address
+
+	// endBlock records the snapshot balances and moves to the next block
+	endBlock := func() {
+		suite.app.AssetKeeper.RecordSnapshotBalances(suite.ctx)
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	}
+	balanceAt := func(address string, id uint64) string {
+		token, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "RST")
+		balance, err := suite.app.AssetKeeper.GetBalanceAt(suite.ctx, token, sdk.MustAccAddressFromBech32(address), id)
+		suite.Require().NoError(err)
+		return balance.String()
+	}
+
+	_, err := srv.CreateToken(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "1000", Decimals: "0"})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(sdk.WrapSDKContext(suite.ctx), &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder1, Amount: "100"})
+	suite.Require().NoError(err)
+
+	// only an issuer of the token can take a snapshot
+	_, err = srv.CreateSnapshot(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateSnapshot{Manager: holder1, Symbol: "RST"})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	res, err := srv.CreateSnapshot(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateSnapshot{Manager: manager, Symbol: "RST"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Id)
+	endBlock()
+
+	// the first change after the snapshot is visible right away, and recorded
+	// at the end of the block
+	_, err = srv.TransferToken(sdk.WrapSDKContext(suite.ctx), &types.MsgTransferToken{Symbol: "RST", From: holder1, To: holder2, Amount: "30"})
+	suite.Require().NoError(err)
+	suite.Require().Equal("100", balanceAt(holder1, 1))
+	suite.Require().Equal("0", balanceAt(holder2, 1))
+	endBlock()
+	suite.Require().Equal("100", balanceAt(holder1, 1))
+	suite.Require().Equal("0", balanceAt(holder2, 1))
+	suite.Require().Equal("900", balanceAt(manager, 1))
+
+	// a snapshot covers the whole block it is taken in, mints included
+	res, err = srv.CreateSnapshot(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateSnapshot{Manager: manager, Symbol: "RST"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Id)
+	_, err = srv.MintToken(sdk.WrapSDKContext(suite.ctx), &types.MsgMintToken{Manager: manager, Symbol: "RST", To: holder2, Amount: "50"})
+	suite.Require().NoError(err)
+	endBlock()
+
+	// a multi-send debits the sender before the restriction sees it
+	err = suite.app.BankKeeper.InputOutputCoins(suite.ctx,
+		banktypes.NewInput(suite.testUser3Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(20)))),
+		[]banktypes.Output{
+			banktypes.NewOutput(suite.testUser1Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(10)))),
+			banktypes.NewOutput(suite.testUser2Acc, sdk.NewCoins(sdk.NewCoin("arst", sdk.NewInt(10)))),
+		},
+	)
+	suite.Require().NoError(err)
+	endBlock()
+
+	suite.Require().Equal("80", balanceAt(holder2, 2))
+	suite.Require().Equal("70", balanceAt(holder1, 2))
+	suite.Require().Equal("900", balanceAt(manager, 2))
+	suite.Require().Equal("0", balanceAt(holder2, 1))
+	suite.Require().Equal("100", balanceAt(holder1, 1))
+	suite.Require().Equal(sdk.NewInt(60), suite.app.BankKeeper.GetBalance(suite.ctx, suite.testUser3Acc, "arst").Amount)
+
+	query, err := suite.queryClient.BalanceAt(sdk.WrapSDKContext(suite.ctx), &types.QueryBalanceAtRequest{Symbol: "RST", Address: holder2, SnapshotId: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal("80", query.Balance)
+	_, err = suite.queryClient.BalanceAt(sdk.WrapSDKContext(suite.ctx), &types.QueryBalanceAtRequest{Symbol: "RST", Address: holder1, SnapshotId: 3})
+	suite.Require().ErrorIs(err, sdkerrors.ErrKeyNotFound)
+}
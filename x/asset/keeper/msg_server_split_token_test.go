@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (suite *KeeperTestSuite) TestSplitToken() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "10", Decimals: "0"})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "5"})
+	suite.Require().NoError(err)
+	_, err = srv.FreezeAddress(wctx, &types.MsgFreezeAddress{Manager: manager, Symbol: "RST", Address: holder})
+	suite.Require().NoError(err)
+
+	// only an issuer of the token can split it
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: holder, Symbol: "RST", Numerator: 1, Denominator: 2})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// a 1-for-2 reverse split leaves a unit of remainder, which goes to the
+	// lower address since both remainders are equal
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: manager, Symbol: "RST", Numerator: 1, Denominator: 2})
+	suite.Require().NoError(err)
+
+	low, high := suite.testUser1Acc, suite.testUser2Acc
+	if low.String() > high.String() {
+		low, high = high, low
+	}
+	suite.Require().Equal(sdk.NewInt(3), suite.app.BankKeeper.GetBalance(suite.ctx, low, "arst").Amount)
+	suite.Require().Equal(sdk.NewInt(2), suite.app.BankKeeper.GetBalance(suite.ctx, high, "arst").Amount)
+	suite.Require().Equal(sdk.NewInt(5), suite.app.BankKeeper.GetSupply(suite.ctx, "arst").Amount)
+	token, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "RST")
+	suite.Require().Equal("5", token.Total)
+
+	splits := 0
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSplitBalance {
+			splits++
+		}
+	}
+	suite.Require().Equal(2, splits)
+
+	// a 3-for-1 split, the frozen holder is rescaled as well
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: manager, Symbol: "RST", Numerator: 3, Denominator: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(9), suite.app.BankKeeper.GetBalance(suite.ctx, low, "arst").Amount)
+	suite.Require().Equal(sdk.NewInt(6), suite.app.BankKeeper.GetBalance(suite.ctx, high, "arst").Amount)
+	suite.Require().Equal(sdk.NewInt(15), suite.app.BankKeeper.GetSupply(suite.ctx, "arst").Amount)
+	token, _ = suite.app.AssetKeeper.GetToken(suite.ctx, "RST")
+	suite.Require().Equal("15", token.Total)
+
+	// pending redemptions are not rescaled, they must be settled first
+	_, err = srv.UnfreezeAddress(wctx, &types.MsgUnfreezeAddress{Manager: manager, Symbol: "RST", Address: holder})
+	suite.Require().NoError(err)
+	_, err = srv.RequestRedemption(wctx, &types.MsgRequestRedemption{Holder: holder, Symbol: "RST", Amount: "1"})
+	suite.Require().NoError(err)
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: manager, Symbol: "RST", Numerator: 2, Denominator: 1})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (suite *KeeperTestSuite) TestSplitTokenDecimalsAndEscrows() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+	balance := func(address sdk.AccAddress) math.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, address, "arst").Amount
+	}
+	tokens := func(amount int64) math.Int {
+		return math.NewIntWithDecimal(amount, 18)
+	}
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{Manager: manager, Symbol: "RST", Total: "10"})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: tokens(4).String()})
+	suite.Require().NoError(err)
+
+	// the base units are rescaled, and the total follows the supply in whole tokens
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: manager, Symbol: "RST", Numerator: 3, Denominator: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(tokens(9), balance(suite.testUser1Acc))
+	suite.Require().Equal(tokens(6), balance(suite.testUser2Acc))
+	suite.Require().Equal(tokens(15), suite.app.BankKeeper.GetSupply(suite.ctx, "arst").Amount)
+	token, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "RST")
+	suite.Require().Equal("15", token.Total)
+
+	// a split leaving a fraction of a token is rejected
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: manager, Symbol: "RST", Numerator: 1, Denominator: 4})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// units held by module accounts cannot be rescaled with the holders
+	module := sdk.NewCoins(sdk.NewCoin("arst", tokens(1)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, suite.testUser2Acc, types.ModuleName, module))
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: manager, Symbol: "RST", Numerator: 2, Denominator: 1})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.testUser2Acc, module))
+
+	// neither can units escrowed by ICS-20 channels
+	suite.app.AssetKeeper.SetTransferEscrow(suite.ctx, "channel-0")
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, suite.testUser2Acc, escrow, module))
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: manager, Symbol: "RST", Numerator: 2, Denominator: 1})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.Require().Equal(tokens(1), balance(escrow))
+	token, _ = suite.app.AssetKeeper.GetToken(suite.ctx, "RST")
+	suite.Require().Equal("15", token.Total)
+}
+
+func (suite *KeeperTestSuite) TestSplitTokenLimits() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	holder := suite.testUser2Address
+
+	_, err := srv.CreateToken(wctx, &types.MsgCreateToken{
+		Manager: manager, Symbol: "RST", Total: "100", Decimals: "0",
+		MaxSupply: "100", MaxBalancePerHolder: "50",
+	})
+	suite.Require().NoError(err)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "50"})
+	suite.Require().NoError(err)
+
+	// the caps follow the split, the token stays at its max supply and the
+	// holder at its max balance
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: manager, Symbol: "RST", Numerator: 2, Denominator: 1})
+	suite.Require().NoError(err)
+	token, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "RST")
+	suite.Require().Equal("200", token.Total)
+	suite.Require().Equal("200", token.MaxSupply)
+	suite.Require().Equal("100", token.MaxBalancePerHolder)
+	_, err = srv.MintToken(wctx, &types.MsgMintToken{Manager: manager, Symbol: "RST", To: manager, Amount: "1"})
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+	_, err = srv.TransferToken(wctx, &types.MsgTransferToken{Symbol: "RST", From: manager, To: holder, Amount: "1"})
+	suite.Require().ErrorIs(err, types.ErrMaxBalanceExceeded)
+
+	// a split leaving a fraction of a cap is rejected
+	_, err = srv.UpdateHolderLimits(wctx, &types.MsgUpdateHolderLimits{Manager: manager, Symbol: "RST", MaxBalancePerHolder: "101"})
+	suite.Require().NoError(err)
+	_, err = srv.SplitToken(wctx, &types.MsgSplitToken{Manager: manager, Symbol: "RST", Numerator: 1, Denominator: 2})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	token, _ = suite.app.AssetKeeper.GetToken(suite.ctx, "RST")
+	suite.Require().Equal("200", token.Total)
+	suite.Require().Equal("200", token.MaxSupply)
+}
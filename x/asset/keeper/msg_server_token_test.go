@@ -184,10 +184,7 @@ func (suite *KeeperTestSuite) TestTokenMsgServerAuthorizeAddress() {
 	_, err := srv.CreateToken(wctx, t1)
 	suite.Require().NoError(err)
 
-	rio, _ := suite.app.AssetKeeper.GetToken(suite.ctx,
-		t1.Symbol,
-	)
-	suite.Require().True(rio.AddressIsAuthorized(suite.testUser1Acc))
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, t1.Symbol, suite.testUser1Acc))
 
 	authUserMsg := &types.MsgAuthorizeAddress{
 		Manager: manager,
@@ -197,11 +194,7 @@ func (suite *KeeperTestSuite) TestTokenMsgServerAuthorizeAddress() {
 	_, err = srv.AuthorizeAddress(wctx, authUserMsg)
 	suite.Require().NoError(err)
 
-	rio, _ = suite.app.AssetKeeper.GetToken(suite.ctx,
-		t1.Symbol,
-	)
-	suite.Require().NotNil(rio.Authorized)
-	suite.Require().True(rio.AddressIsAuthorized(suite.testUser1Acc))
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, t1.Symbol, suite.testUser2Acc))
 }
 
 func (suite *KeeperTestSuite) TestTokenMsgServerAuthorizeTokenNotFound() {
@@ -268,10 +261,7 @@ func (suite *KeeperTestSuite) TestTokenMsgServerUnAuthorizeAddress() {
 	_, err := srv.CreateToken(wctx, t1)
 	suite.Require().NoError(err)
 
-	rio, _ := suite.app.AssetKeeper.GetToken(suite.ctx,
-		t1.Symbol,
-	)
-	suite.Require().True(rio.AddressIsAuthorized(suite.testUser1Acc))
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, t1.Symbol, suite.testUser1Acc))
 
 	authUserMsg := &types.MsgAuthorizeAddress{
 		Manager: manager,
@@ -281,10 +271,7 @@ func (suite *KeeperTestSuite) TestTokenMsgServerUnAuthorizeAddress() {
 	_, err = srv.AuthorizeAddress(wctx, authUserMsg)
 	suite.Require().NoError(err)
 
-	rio, _ = suite.app.AssetKeeper.GetToken(suite.ctx,
-		t1.Symbol,
-	)
-	suite.Require().True(rio.AddressIsAuthorized(suite.testUser2Acc))
+	suite.Require().True(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, t1.Symbol, suite.testUser2Acc))
 
 	unAuthUserMsg := &types.MsgUnAuthorizeAddress{
 		Manager: manager,
@@ -294,10 +281,7 @@ func (suite *KeeperTestSuite) TestTokenMsgServerUnAuthorizeAddress() {
 	_, err = srv.UnAuthorizeAddress(wctx, unAuthUserMsg)
 	suite.Require().NoError(err)
 
-	rio, _ = suite.app.AssetKeeper.GetToken(suite.ctx,
-		t1.Symbol,
-	)
-	suite.Require().False(rio.AddressIsAuthorized(suite.testUser2Acc))
+	suite.Require().False(suite.app.AssetKeeper.IsAddressAuthorizedToSend(suite.ctx, t1.Symbol, suite.testUser2Acc))
 }
 
 func (suite *KeeperTestSuite) TestTokenMsgServerUnAuthorizeTokenNotFound() {
@@ -348,3 +332,49 @@ func (suite *KeeperTestSuite) TestTokenMsgServerUnAuthorizeAddressSenderUnauthor
 
 	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
 }
+
+func (suite *KeeperTestSuite) TestTokenMsgServerCreateDecimals() {
+	suite.SetupTest()
+
+	srv := keeper.NewMsgServerImpl(suite.app.AssetKeeper)
+	wctx := sdk.WrapSDKContext(suite.ctx)
+	manager := suite.testUser1Address
+	t1 := &types.MsgCreateToken{
+		Manager: manager,
+		Name:    "Share", Symbol: "SHR", Total: "1000", Decimals: "0",
+		Description: "share class A", Uri: "https://example.com/shr.json", UriHash: "abcd",
+	}
+	_, err := srv.CreateToken(wctx, t1)
+	suite.Require().NoError(err)
+
+	// share tokens are minted one base unit per share
+	account, _ := sdk.AccAddressFromBech32(manager)
+	suite.Require().Equal(math.NewInt(1000), suite.app.BankKeeper.GetBalance(suite.ctx, account, "ashr").Amount)
+
+	_, err = srv.MintToken(wctx, &types.MsgMintToken{Manager: manager, Symbol: "SHR", To: manager, Amount: "5"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1005), suite.app.BankKeeper.GetSupply(suite.ctx, "ashr").Amount)
+
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, "ashr")
+	suite.Require().True(found)
+	suite.Require().NoError(metadata.Validate())
+	suite.Require().Len(metadata.DenomUnits, 1)
+	suite.Require().Equal("share class A", metadata.Description)
+	suite.Require().Equal("https://example.com/shr.json", metadata.URI)
+
+	shr, _ := suite.app.AssetKeeper.GetToken(suite.ctx, "SHR")
+	suite.Require().Equal(uint32(0), shr.Decimals)
+	suite.Require().Equal("abcd", shr.UriHash)
+
+	t2 := &types.MsgCreateToken{
+		Manager: manager,
+		Name:    "Dollar", Symbol: "USD", Total: "10", Decimals: "6",
+	}
+	_, err = srv.CreateToken(wctx, t2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(10_000_000), suite.app.BankKeeper.GetBalance(suite.ctx, account, "ausd").Amount)
+
+	metadata, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, "ausd")
+	suite.Require().NoError(metadata.Validate())
+	suite.Require().Equal("usd", metadata.Display)
+}
@@ -18,7 +18,6 @@ func (k msgServer) TransferToken(goCtx context.Context, msg *types.MsgTransferTo
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	var fromAddress, toAddress sdk.AccAddress
-	isAuthorizedFrom, isAuthorizedTo := true, true
 
 	fromAddress, _ = sdk.AccAddressFromBech32(msg.From)
 	toAddress, _ = sdk.AccAddressFromBech32(msg.To)
@@ -31,25 +30,24 @@ func (k msgServer) TransferToken(goCtx context.Context, msg *types.MsgTransferTo
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "token %s not found", msg.Symbol)
 	}
 
-	if token.AuthorizationRequired {
-		isAuthorizedFrom = k.IsAddressAuthorizedToSend(ctx, msg.Symbol, fromAddress)
-		isAuthorizedTo = k.IsAddressAuthorizedToSend(ctx, msg.Symbol, toAddress)
+	totalInt, totalIsValid := math.NewIntFromString(msg.Amount)
+	if !totalIsValid {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin amount %s", msg.Amount)
 	}
 
-	if isAuthorizedFrom && isAuthorizedTo {
-		totalInt, totalIsValid := math.NewIntFromString(msg.Amount)
-		if !totalIsValid {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin amount %s", msg.Amount)
-		}
-
-		baseDenom := fmt.Sprintf("a%s", strings.ToLower(msg.Symbol))
-		coin := sdk.Coins{{Denom: baseDenom, Amount: totalInt}}
-		err := k.bankKeeper.SendCoins(ctx, fromAddress, toAddress, coin)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s transfer not authorized", msg.Symbol)
+	baseDenom := fmt.Sprintf("a%s", strings.ToLower(msg.Symbol))
+	coin := sdk.Coins{{Denom: baseDenom, Amount: totalInt}}
+
+	// the send restriction checks the same rules, they are checked here first
+	// so the transfer fails before touching any balance
+	transfer := types.ComplianceTransfer{From: fromAddress, To: toAddress, Coin: coin[0]}
+	if err := k.checkTransfer(ctx, token, transfer); err != nil {
+		return nil, err
+	}
+
+	err := k.bankKeeper.SendCoins(ctx, fromAddress, toAddress, coin)
+	if err != nil {
+		return nil, err
 	}
 
 	return &types.MsgTransferTokenResponse{}, nil
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func (k msgServer) UpdateHolderLimits(goCtx context.Context, msg *types.MsgUpdateHolderLimits) (*types.MsgUpdateHolderLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the value exists
+	token, isFound := k.GetToken(ctx, msg.Symbol)
+	if !isFound {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
+	}
+
+	// Checks if a single account signed
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
+	}
+
+	// assert that the signer holds the admin role of the token
+	if !k.HasRole(ctx, token, signers[0].String(), types.RoleAdmin) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
+	}
+
+	// lowering a limit below the current state only blocks new holders and
+	// larger balances, existing holders keep what they have
+	token.MaxHolders = msg.MaxHolders
+	token.MaxBalancePerHolder = msg.MaxBalancePerHolder
+	k.SetToken(ctx, token)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHolderLimitsUpdated,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyMaxHolders, fmt.Sprint(msg.MaxHolders)),
+			sdk.NewAttribute(types.AttributeKeyMaxBalancePerHolder, msg.MaxBalancePerHolder),
+		),
+	)
+
+	return &types.MsgUpdateHolderLimitsResponse{}, nil
+}
@@ -16,27 +16,27 @@ func (k msgServer) UpdateToken(goCtx context.Context, msg *types.MsgUpdateToken)
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", msg.Symbol)
 	}
 
-	// Checks if the token manager signed
+	// Checks if a single account signed
 	signers := msg.GetSigners()
 	if len(signers) != 1 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid signers")
 	}
 
-	// assert that the manager account is the only signer of the message
-	if signers[0].String() != existing.Manager {
+	// assert that the signer holds the admin role of the token
+	if !k.HasRole(ctx, existing, signers[0].String(), types.RoleAdmin) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller not authorized")
 	}
 
-	// only Authorization Flag is updatable at this time
-	token := types.Token{
-		Name:                  existing.Name,
-		Symbol:                existing.Symbol,
-		Total:                 existing.Total,
-		Manager:               existing.Manager,
-		AuthorizationRequired: msg.AuthorizationRequired,
+	// only Authorization Flag is updatable at this time, it switches between
+	// allowlist and open mode and leaves a denylist token as it is
+	switch {
+	case msg.AuthorizationRequired:
+		existing.SetRestrictionMode(types.RestrictionModeAllowlist)
+	case existing.EffectiveRestrictionMode() == types.RestrictionModeAllowlist:
+		existing.SetRestrictionMode(types.RestrictionModeOpen)
 	}
 
-	k.SetToken(ctx, token)
+	k.SetToken(ctx, existing)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
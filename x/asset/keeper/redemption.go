@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// SetRedemptionRequest set a specific redemption request in the store from its symbol and holder
+func (k Keeper) SetRedemptionRequest(ctx sdk.Context, redemption types.RedemptionRequest) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKeyPrefix))
+	lowerCased := strings.ToLower(redemption.Symbol)
+	b := k.cdc.MustMarshal(&redemption)
+	store.Set(types.RedemptionKey(
+		lowerCased,
+		redemption.Holder,
+	), b)
+}
+
+// GetRedemptionRequest returns a redemption request from its symbol and holder
+func (k Keeper) GetRedemptionRequest(
+	ctx sdk.Context,
+	symbol string,
+	holder string,
+) (val types.RedemptionRequest, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	b := store.Get(types.RedemptionKey(
+		lowerCased,
+		holder,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRedemptionRequest removes a redemption request from the store
+func (k Keeper) RemoveRedemptionRequest(
+	ctx sdk.Context,
+	symbol string,
+	holder string,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	store.Delete(types.RedemptionKey(
+		lowerCased,
+		holder,
+	))
+}
+
+// GetAllRedemptionRequest returns all redemption requests
+func (k Keeper) GetAllRedemptionRequest(ctx sdk.Context) (list []types.RedemptionRequest) {
+	return k.getRedemptionRequests(ctx, []byte{})
+}
+
+// GetTokenRedemptionRequests returns all redemption requests of a token
+func (k Keeper) GetTokenRedemptionRequests(ctx sdk.Context, symbol string) (list []types.RedemptionRequest) {
+	return k.getRedemptionRequests(ctx, types.TokenKey(strings.ToLower(symbol)))
+}
+
+func (k Keeper) getRedemptionRequests(ctx sdk.Context, keyPrefix []byte) (list []types.RedemptionRequest) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RedemptionRequest
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -3,53 +3,156 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/realiotech/realio-network/x/asset/types"
 )
 
 func (k Keeper) AssetSendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error) {
 	newToAddr = toAddr
-	err = nil
-
-	// module whitelisted addresses can send coins without restrictions
-	if allow := k.AllowAddr(fromAddr); allow {
-		return newToAddr, nil
-	}
 
 	for _, coin := range amt {
-		// Check if the value already exists
-		// fetch bank metadata to get symbol from denom
-		symbol := coin.Denom
-		tokenMetadata, found := k.bankKeeper.GetDenomMetaData(ctx, coin.Denom)
-		if found {
-			symbol = tokenMetadata.Symbol
-		}
-		token, isFound := k.GetToken(
-			ctx,
-			symbol,
-		)
+		token, isFound := k.GetTokenByDenom(ctx, coin.Denom)
 		if !isFound {
 			continue
 		}
 
-		var isAuthorizedFrom, isAuthorizedTo bool
-		if token.AuthorizationRequired {
-			isAuthorizedFrom = k.IsAddressAuthorizedToSend(ctx, symbol, fromAddr)
-			isAuthorizedTo = k.IsAddressAuthorizedToSend(ctx, symbol, toAddr)
-		} else {
-			continue
+		// forced transfers are already checked by the msg server, but they
+		// still move holders of the token
+		if !hasRestrictionBypass(ctx) {
+			transfer := types.ComplianceTransfer{From: fromAddr, To: toAddr, Coin: coin}
+			if err := k.checkTransfer(ctx, token, transfer); err != nil {
+				return nil, err
+			}
 		}
 
-		if isAuthorizedFrom && isAuthorizedTo {
-			continue
-		} else { //nolint:revive // superfluous else, could fix, but not worth it?
-			err = sdkerrors.Wrapf(types.ErrNotAuthorized, "%s is not authorized to transact with %s", fromAddr, coin.Denom)
-			break
+		if err := k.updateHolders(ctx, token, fromAddr, toAddr, coin, !hasRestrictionBypass(ctx)); err != nil {
+			return nil, err
+		}
+		k.syncERC20Transfer(ctx, token, fromAddr, toAddr, coin)
+		k.trackSnapshotBalances(ctx, token, fromAddr, toAddr, coin)
+	}
+	return newToAddr, nil
+}
+
+// GetTokenByDenom returns the token whose bank denom is denom
+func (k Keeper) GetTokenByDenom(ctx sdk.Context, denom string) (types.Token, bool) {
+	// fetch bank metadata to get symbol from denom
+	symbol := denom
+	tokenMetadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if found {
+		symbol = tokenMetadata.Symbol
+	}
+	return k.GetToken(ctx, symbol)
+}
+
+// checkTransfer checks that the token is not paused and evaluates its enabled
+// compliance rules in order
+func (k Keeper) checkTransfer(ctx sdk.Context, token types.Token, transfer types.ComplianceTransfer) error {
+	// a paused token cannot move outside of module accounts
+	if token.Paused && !k.AllowAddr(transfer.From) {
+		return sdkerrors.Wrapf(types.ErrTokenPaused, "%s transfers are paused", transfer.Coin.Denom)
+	}
+
+	for _, config := range token.GetEnabledComplianceRules() {
+		rule, found := k.GetComplianceRule(config.Id)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrUnknownComplianceRule, "%s enables unknown rule %s", token.Symbol, config.Id)
+		}
+		if err := rule.Check(ctx, k, token, config.Params, transfer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateHolders moves the holders of the token for a send of coin from
+// fromAddr to toAddr. When enforce is set, the send is rejected if it would
+// exceed the max holders of the token. Module accounts and ICS-20 escrow
+// accounts are never counted as holders.
+func (k Keeper) updateHolders(ctx sdk.Context, token types.Token, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin, enforce bool) error {
+	if !coin.Amount.IsPositive() {
+		return nil
+	}
+
+	// SendCoins runs the restriction before debiting the sender, while
+	// InputOutputCoins has already debited the whole input, including the
+	// outputs sent back to the sender itself
+	debited := hasInputDebited(ctx)
+	if fromAddr.Equals(toAddr) && !debited {
+		return nil
+	}
+
+	addTo := !k.AllowAddr(toAddr) && !k.IsHolder(ctx, token.Symbol, toAddr) && !k.IsTransferEscrow(ctx, toAddr)
+
+	removeFrom := false
+	if !fromAddr.Equals(toAddr) && !k.AllowAddr(fromAddr) && k.IsHolder(ctx, token.Symbol, fromAddr) {
+		balance := k.bankKeeper.GetBalance(ctx, fromAddr, coin.Denom).Amount
+		if !debited {
+			balance = balance.Sub(coin.Amount)
+		}
+		removeFrom = !balance.IsPositive()
+	}
+
+	if enforce && addTo && token.MaxHolders > 0 {
+		count := k.GetHolderCount(ctx, token.Symbol) + 1
+		if removeFrom {
+			count--
+		}
+		if count > token.MaxHolders {
+			return sdkerrors.Wrapf(types.ErrMaxHoldersExceeded, "%s is limited to %d holders", token.Symbol, token.MaxHolders)
 		}
 	}
-	return newToAddr, err
+
+	if removeFrom {
+		k.removeHolder(ctx, token.Symbol, fromAddr)
+	}
+	if addTo {
+		k.setHolder(ctx, token.Symbol, toAddr)
+	}
+
+	return nil
 }
 
 // AllowAddr addr checks if a given address is in the list of allowAddrs to skip restrictions
 func (k Keeper) AllowAddr(addr sdk.AccAddress) bool {
 	return k.allowAddrs[addr.String()]
 }
+
+type restrictionBypassKey struct{}
+
+// withRestrictionBypass returns a context under which AssetSendRestriction lets every send through
+func withRestrictionBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(restrictionBypassKey{}, true)
+}
+
+// hasRestrictionBypass checks if the context was marked by withRestrictionBypass
+func hasRestrictionBypass(ctx sdk.Context) bool {
+	bypass, ok := ctx.Value(restrictionBypassKey{}).(bool)
+	return ok && bypass
+}
+
+type inputDebitedKey struct{}
+
+// hasInputDebited checks if the send is an output of InputOutputCoins, whose
+// input is debited before the restriction runs
+func hasInputDebited(ctx sdk.Context) bool {
+	debited, ok := ctx.Value(inputDebitedKey{}).(bool)
+	return ok && debited
+}
+
+// multiSendKeeper marks the context of InputOutputCoins for AssetSendRestriction
+type multiSendKeeper struct {
+	bankkeeper.Keeper
+}
+
+// WrapBankKeeper returns the bank keeper with its multi-sends marked, so that
+// AssetSendRestriction knows their sender is already debited when it runs
+func WrapBankKeeper(bankKeeper bankkeeper.Keeper) bankkeeper.Keeper {
+	return multiSendKeeper{Keeper: bankKeeper}
+}
+
+func (k multiSendKeeper) InputOutputCoins(ctx sdk.Context, input banktypes.Input, outputs []banktypes.Output) error {
+	return k.Keeper.InputOutputCoins(ctx.WithValue(inputDebitedKey{}, true), input, outputs)
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// SetRole set a specific role assignment in the store
+func (k Keeper) SetRole(ctx sdk.Context, assignment types.RoleAssignment) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoleKeyPrefix))
+	lowerCased := strings.ToLower(assignment.Symbol)
+	b := k.cdc.MustMarshal(&assignment)
+	store.Set(types.RoleKey(
+		lowerCased,
+		assignment.Address,
+		assignment.Role,
+	), b)
+}
+
+// RemoveRole removes a role assignment from the store
+func (k Keeper) RemoveRole(ctx sdk.Context, symbol string, address string, role types.Role) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoleKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	store.Delete(types.RoleKey(
+		lowerCased,
+		address,
+		role,
+	))
+}
+
+// IsRoleGranted returns whether the role was delegated to the address
+func (k Keeper) IsRoleGranted(ctx sdk.Context, symbol string, address string, role types.Role) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoleKeyPrefix))
+	lowerCased := strings.ToLower(symbol)
+	return store.Has(types.RoleKey(
+		lowerCased,
+		address,
+		role,
+	))
+}
+
+// HasRole returns whether the address may act with the role on the token,
+// the token manager implicitly holds every role
+func (k Keeper) HasRole(ctx sdk.Context, token types.Token, address string, role types.Role) bool {
+	if address == token.Manager {
+		return true
+	}
+	return k.IsRoleGranted(ctx, token.Symbol, address, role)
+}
+
+// GetAllRole returns all role assignments
+func (k Keeper) GetAllRole(ctx sdk.Context) (list []types.RoleAssignment) {
+	return k.getRoles(ctx, []byte{})
+}
+
+// GetTokenRoles returns all role assignments of a token
+func (k Keeper) GetTokenRoles(ctx sdk.Context, symbol string) (list []types.RoleAssignment) {
+	return k.getRoles(ctx, types.TokenKey(strings.ToLower(symbol)))
+}
+
+func (k Keeper) getRoles(ctx sdk.Context, keyPrefix []byte) (list []types.RoleAssignment) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RoleKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RoleAssignment
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// GetSettlementCount returns the number of settlements created, which is the id of the next one
+func (k Keeper) GetSettlementCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(types.SettlementCountKey))
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetSettlementCount sets the number of settlements created
+func (k Keeper) SetSettlementCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.SettlementCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// SetSettlement set a specific settlement in the store from its id, and
+// queues it for expiry
+func (k Keeper) SetSettlement(ctx sdk.Context, settlement types.Settlement) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SettlementKeyPrefix))
+	b := k.cdc.MustMarshal(&settlement)
+	store.Set(types.SettlementKey(settlement.Id), b)
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SettlementExpiryKeyPrefix))
+	expiryStore.Set(types.SettlementExpiryKey(settlement.Expiry, settlement.Id), types.SettlementKey(settlement.Id))
+}
+
+// GetSettlement returns a pending settlement from its id
+func (k Keeper) GetSettlement(ctx sdk.Context, id uint64) (val types.Settlement, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SettlementKeyPrefix))
+	b := store.Get(types.SettlementKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveSettlement removes a settlement and its expiry from the store
+func (k Keeper) RemoveSettlement(ctx sdk.Context, settlement types.Settlement) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SettlementKeyPrefix))
+	store.Delete(types.SettlementKey(settlement.Id))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SettlementExpiryKeyPrefix))
+	expiryStore.Delete(types.SettlementExpiryKey(settlement.Expiry, settlement.Id))
+}
+
+// GetAllSettlement returns all pending settlements
+func (k Keeper) GetAllSettlement(ctx sdk.Context) (list []types.Settlement) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SettlementKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Settlement
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// settlementTransfer returns the token of a leg and the transfer delivering it from one party to the
+// other. Legs are in whole units, like the amounts of the other token messages.
+func (k Keeper) settlementTransfer(ctx sdk.Context, leg types.SettlementLeg, from, to string) (types.Token, types.ComplianceTransfer, error) {
+	token, found := k.GetToken(ctx, leg.Symbol)
+	if !found {
+		return token, types.ComplianceTransfer{}, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "symbol %s does not exists", leg.Symbol)
+	}
+	amount, isValid := math.NewIntFromString(leg.Amount)
+	if !isValid || !amount.IsPositive() {
+		return token, types.ComplianceTransfer{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid %s leg amount %s", leg.Symbol, leg.Amount)
+	}
+
+	transfer := types.ComplianceTransfer{
+		From: sdk.MustAccAddressFromBech32(from),
+		To:   sdk.MustAccAddressFromBech32(to),
+		Coin: tokenCoins(token, amount)[0],
+	}
+	return token, transfer, nil
+}
+
+// checkSettlement checks both legs of a settlement against the rules of their
+// token, as transfers between the parties rather than through the escrow
+func (k Keeper) checkSettlement(ctx sdk.Context, settlement types.Settlement) ([]types.ComplianceTransfer, error) {
+	legs := []struct {
+		leg      types.SettlementLeg
+		from, to string
+	}{
+		{settlement.CreatorLeg, settlement.Creator, settlement.Counterparty},
+		{settlement.CounterpartyLeg, settlement.Counterparty, settlement.Creator},
+	}
+
+	transfers := make([]types.ComplianceTransfer, 0, len(legs))
+	for _, l := range legs {
+		token, transfer, err := k.settlementTransfer(ctx, l.leg, l.from, l.to)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.checkTransfer(ctx, token, transfer); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, nil
+}
+
+// escrowSettlementLeg moves the leg of a transfer from its sender to the
+// module account. The restriction does not know the final recipient, the
+// transfer is checked by checkSettlement instead.
+func (k Keeper) escrowSettlementLeg(ctx sdk.Context, transfer types.ComplianceTransfer) error {
+	return k.bankKeeper.SendCoinsFromAccountToModule(withRestrictionBypass(ctx), transfer.From, types.ModuleName, sdk.NewCoins(transfer.Coin))
+}
+
+// createSettlement checks both legs of a settlement and escrows the leg of its creator
+func (k Keeper) createSettlement(ctx sdk.Context, settlement types.Settlement) (types.Settlement, error) {
+	settlement.CreatorLeg.Symbol = strings.ToLower(settlement.CreatorLeg.Symbol)
+	settlement.CounterpartyLeg.Symbol = strings.ToLower(settlement.CounterpartyLeg.Symbol)
+
+	transfers, err := k.checkSettlement(ctx, settlement)
+	if err != nil {
+		return settlement, err
+	}
+	if err := k.escrowSettlementLeg(ctx, transfers[0]); err != nil {
+		return settlement, err
+	}
+
+	settlement.Id = k.GetSettlementCount(ctx)
+	k.SetSettlementCount(ctx, settlement.Id+1)
+	k.SetSettlement(ctx, settlement)
+	return settlement, nil
+}
+
+// affirmSettlement checks both legs of a settlement again, escrows the leg of
+// the counterparty, then delivers both legs. The deliveries go through the
+// restriction, which enforces the max holders of each token.
+func (k Keeper) affirmSettlement(ctx sdk.Context, settlement types.Settlement) error {
+	transfers, err := k.checkSettlement(ctx, settlement)
+	if err != nil {
+		return err
+	}
+	if err := k.escrowSettlementLeg(ctx, transfers[1]); err != nil {
+		return err
+	}
+
+	for _, transfer := range transfers {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, transfer.To, sdk.NewCoins(transfer.Coin)); err != nil {
+			return err
+		}
+	}
+
+	k.RemoveSettlement(ctx, settlement)
+	return nil
+}
+
+// ExpireSettlements refunds the escrowed leg of the settlements that expired by
+// the current block time, at most MaxSettlementExpiriesPerBlock of them, and emits
+// a settlement_expired event for each of them. The ones left for the next blocks
+// can no longer be affirmed.
+func (k Keeper) ExpireSettlements(ctx sdk.Context) {
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SettlementExpiryKeyPrefix))
+	// every key up to the current block time, the queue is ordered by expiry
+	end := sdk.PrefixEndBytes(append(sdk.FormatTimeBytes(ctx.BlockTime()), []byte("/")...))
+	iterator := expiryStore.Iterator(nil, end)
+
+	var expired []types.Settlement
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SettlementKeyPrefix))
+	for ; iterator.Valid() && len(expired) < types.MaxSettlementExpiriesPerBlock; iterator.Next() {
+		var settlement types.Settlement
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &settlement)
+		expired = append(expired, settlement)
+	}
+	iterator.Close()
+
+	for _, settlement := range expired {
+		cacheCtx, write := ctx.CacheContext()
+		refund, err := k.refundSettlement(cacheCtx, settlement)
+		if err != nil {
+			k.Logger(ctx).Error("failed to refund expired settlement", "settlement", settlement.Id, "error", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSettlementExpired,
+				sdk.NewAttribute(types.AttributeKeySettlement, strconv.FormatUint(settlement.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyCreator, settlement.Creator),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+			),
+		)
+	}
+}
+
+// refundSettlement returns the escrowed leg of a settlement to its creator and
+// removes the settlement. The refund is not subject to the rules of the token,
+// the units return to the account they were escrowed from.
+func (k Keeper) refundSettlement(ctx sdk.Context, settlement types.Settlement) (sdk.Coin, error) {
+	_, transfer, err := k.settlementTransfer(ctx, settlement.CreatorLeg, settlement.Creator, settlement.Counterparty)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(withRestrictionBypass(ctx), types.ModuleName, transfer.From, sdk.NewCoins(transfer.Coin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.RemoveSettlement(ctx, settlement)
+	return transfer.Coin, nil
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// GetSnapshotCount returns the number of snapshots of a token, which is the id of the latest one
+func (k Keeper) GetSnapshotCount(ctx sdk.Context, symbol string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SnapshotCountKeyPrefix))
+	b := store.Get(types.TokenKey(strings.ToLower(symbol)))
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetSnapshot set a specific snapshot in the store from its symbol and id, and
+// counts it as the latest snapshot of the token when its id is higher
+func (k Keeper) SetSnapshot(ctx sdk.Context, snapshot types.Snapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SnapshotKeyPrefix))
+	lowerCased := strings.ToLower(snapshot.Symbol)
+	b := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.SnapshotKey(lowerCased, snapshot.Id), b)
+
+	if snapshot.Id > k.GetSnapshotCount(ctx, lowerCased) {
+		countStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SnapshotCountKeyPrefix))
+		countStore.Set(types.TokenKey(lowerCased), sdk.Uint64ToBigEndian(snapshot.Id))
+	}
+}
+
+// GetSnapshot returns a snapshot from its symbol and id
+func (k Keeper) GetSnapshot(ctx sdk.Context, symbol string, id uint64) (val types.Snapshot, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SnapshotKeyPrefix))
+	b := store.Get(types.SnapshotKey(strings.ToLower(symbol), id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllSnapshot returns the snapshots of all tokens
+func (k Keeper) GetAllSnapshot(ctx sdk.Context) (list []types.Snapshot) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SnapshotKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Snapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetSnapshotBalance set a specific snapshot balance in the store from its symbol, address and snapshot id
+func (k Keeper) SetSnapshotBalance(ctx sdk.Context, balance types.SnapshotBalance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SnapshotBalanceKeyPrefix))
+	lowerCased := strings.ToLower(balance.Symbol)
+	balance.Symbol = lowerCased
+	b := k.cdc.MustMarshal(&balance)
+	store.Set(types.SnapshotBalanceKey(lowerCased, balance.Address, balance.SnapshotId), b)
+}
+
+// GetAllSnapshotBalance returns the balances recorded for the snapshots of all tokens
+func (k Keeper) GetAllSnapshotBalance(ctx sdk.Context) (list []types.SnapshotBalance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SnapshotBalanceKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SnapshotBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// createSnapshot records a new snapshot of the token, as of the end of the current block
+func (k Keeper) createSnapshot(ctx sdk.Context, token types.Token) types.Snapshot {
+	snapshot := types.Snapshot{
+		Symbol: strings.ToLower(token.Symbol),
+		Id:     k.GetSnapshotCount(ctx, token.Symbol) + 1,
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	}
+	k.SetSnapshot(ctx, snapshot)
+	return snapshot
+}
+
+// GetBalanceAt returns the balance of an address at a snapshot of the token.
+// The balance recorded for the first snapshot from snapshotID onwards is the
+// balance at snapshotID, as the address did not move in between. Without a
+// recorded balance, the address did not move since, but for the changes of the
+// current block that are only recorded by the EndBlocker.
+func (k Keeper) GetBalanceAt(ctx sdk.Context, token types.Token, address sdk.AccAddress, snapshotID uint64) (math.Int, error) {
+	snapshot, found := k.GetSnapshot(ctx, token.Symbol, snapshotID)
+	if !found {
+		return math.Int{}, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "snapshot %d of %s does not exists", snapshotID, token.Symbol)
+	}
+
+	lowerCased := strings.ToLower(token.Symbol)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SnapshotBalanceKeyPrefix))
+	store = prefix.NewStore(store, types.SnapshotBalancePrefix(lowerCased, address.String()))
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(snapshotID), nil)
+	defer iterator.Close()
+	if iterator.Valid() {
+		var val types.SnapshotBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		balance, _ := math.NewIntFromString(val.Balance)
+		return balance, nil
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, address, tokenDenom(token)).Amount
+	if snapshot.Height < ctx.BlockHeight() {
+		balance = balance.Sub(k.getSnapshotDelta(ctx, lowerCased, address.String()))
+	}
+	return balance, nil
+}
+
+// trackSnapshotBalances adds a send of coin from fromAddr to toAddr to the
+// balance changes of the block, for tokens that have snapshots
+func (k Keeper) trackSnapshotBalances(ctx sdk.Context, token types.Token, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin) {
+	if fromAddr.Equals(toAddr) || k.GetSnapshotCount(ctx, token.Symbol) == 0 {
+		return
+	}
+	lowerCased := strings.ToLower(token.Symbol)
+	k.addSnapshotDelta(ctx, lowerCased, fromAddr.String(), coin.Amount.Neg())
+	k.addSnapshotDelta(ctx, lowerCased, toAddr.String(), coin.Amount)
+}
+
+// trackSnapshotSupply adds a mint, or a burn with a negative amount, to the
+// balance changes of the module account, which the bank does not send through
+// the restriction
+func (k Keeper) trackSnapshotSupply(ctx sdk.Context, token types.Token, amount math.Int) {
+	if k.GetSnapshotCount(ctx, token.Symbol) == 0 {
+		return
+	}
+	k.addSnapshotDelta(ctx, strings.ToLower(token.Symbol), authtypes.NewModuleAddress(types.ModuleName).String(), amount)
+}
+
+func (k Keeper) getSnapshotDelta(ctx sdk.Context, symbol string, address string) math.Int {
+	store := prefix.NewStore(ctx.TransientStore(k.tkey), types.KeyPrefix(types.SnapshotDeltaKeyPrefix))
+	b := store.Get(types.SnapshotDeltaKey(symbol, address))
+	if b == nil {
+		return math.ZeroInt()
+	}
+	delta, _ := math.NewIntFromString(string(b))
+	return delta
+}
+
+func (k Keeper) addSnapshotDelta(ctx sdk.Context, symbol string, address string, amount math.Int) {
+	store := prefix.NewStore(ctx.TransientStore(k.tkey), types.KeyPrefix(types.SnapshotDeltaKeyPrefix))
+	delta := k.getSnapshotDelta(ctx, symbol, address).Add(amount)
+	store.Set(types.SnapshotDeltaKey(symbol, address), []byte(delta.String()))
+}
+
+// RecordSnapshotBalances records, for every address whose balance of a
+// snapshotted token changed during the block, its balance at the start of the
+// block as its balance at the latest snapshot taken before the block. The
+// balance is only recorded on the first change after that snapshot.
+func (k Keeper) RecordSnapshotBalances(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.TransientStore(k.tkey), types.KeyPrefix(types.SnapshotDeltaKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+
+		// the key is symbol/address/, neither of which contains a separator
+		parts := strings.Split(string(iterator.Key()), "/")
+		symbol, bech32 := parts[0], parts[1]
+		delta, _ := math.NewIntFromString(string(iterator.Value()))
+
+		token, found := k.GetToken(ctx, symbol)
+		if !found {
+			continue
+		}
+		snapshot, found := k.latestSnapshotBefore(ctx, symbol, ctx.BlockHeight())
+		if !found {
+			continue
+		}
+		balanceStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SnapshotBalanceKeyPrefix))
+		if balanceStore.Has(types.SnapshotBalanceKey(symbol, bech32, snapshot.Id)) {
+			continue
+		}
+		address, err := sdk.AccAddressFromBech32(bech32)
+		if err != nil {
+			continue
+		}
+
+		balance := k.bankKeeper.GetBalance(ctx, address, tokenDenom(token)).Amount.Sub(delta)
+		k.SetSnapshotBalance(ctx, types.SnapshotBalance{
+			Symbol:     symbol,
+			Address:    bech32,
+			SnapshotId: snapshot.Id,
+			Balance:    balance.String(),
+		})
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// latestSnapshotBefore returns the latest snapshot of a token taken before the given height
+func (k Keeper) latestSnapshotBefore(ctx sdk.Context, symbol string, height int64) (types.Snapshot, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SnapshotKeyPrefix))
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.TokenKey(strings.ToLower(symbol)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.Snapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if snapshot.Height < height {
+			return snapshot, true
+		}
+	}
+	return types.Snapshot{}, false
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"sort"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// splitToken rescales the balance of every holder of the token by
+// numerator/denominator. Balances are rounded down, then the base units left
+// over to reach the rescaled sum of the balances go one each to the holders
+// with the largest rounded off remainders, the lower address first on ties.
+// The holders must hold the whole supply, which must rescale to whole tokens:
+// the token total is the rescaled supply in whole units. The max supply and the
+// max balance per holder are rescaled as well, and must stay whole too.
+func (k Keeper) splitToken(ctx sdk.Context, token types.Token, numerator, denominator uint64) (types.Token, error) {
+	// units outside of the holder balances cannot be rescaled with them
+	if len(k.GetTokenRedemptionRequests(ctx, token.Symbol)) > 0 {
+		return token, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s has pending redemption requests", token.Symbol)
+	}
+	for _, escrow := range k.GetAllChannelEscrow(ctx) {
+		if escrow.Symbol == token.Symbol {
+			if amount, _ := math.NewIntFromString(escrow.Amount); amount.IsPositive() {
+				return token, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s has units escrowed on %s", token.Symbol, escrow.Channel)
+			}
+		}
+	}
+	for _, settlement := range k.GetAllSettlement(ctx) {
+		if strings.EqualFold(settlement.CreatorLeg.Symbol, token.Symbol) {
+			return token, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s has units escrowed in settlement %d", token.Symbol, settlement.Id)
+		}
+	}
+
+	num := math.NewIntFromUint64(numerator)
+	den := math.NewIntFromUint64(denominator)
+	denom := tokenDenom(token)
+
+	holders := k.getHolders(ctx, token.Symbol)
+	before := make([]math.Int, len(holders))
+	after := make([]math.Int, len(holders))
+	remainders := make([]math.Int, len(holders))
+	sum, rescaled := math.ZeroInt(), math.ZeroInt()
+	for i, holder := range holders {
+		before[i] = k.bankKeeper.GetBalance(ctx, holder, denom).Amount
+		scaled := before[i].Mul(num)
+		after[i] = scaled.Quo(den)
+		remainders[i] = scaled.Mod(den)
+		sum = sum.Add(before[i])
+		rescaled = rescaled.Add(after[i])
+	}
+
+	// module and escrow accounts, ICS-20 ones included, are not holders
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if !sum.Equal(supply) {
+		return token, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s%s are held outside of the holders of %s", supply.Sub(sum), denom, token.Symbol)
+	}
+	unit := token.BaseUnits(math.OneInt())
+	total := supply.Mul(num).Quo(den)
+	if !total.Mod(unit).IsZero() {
+		return token, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "splitting %s by %d/%d leaves a fraction of a token", token.Symbol, numerator, denominator)
+	}
+	maxSupply, err := rescaleLimit(token.MaxSupply, num, den)
+	if err != nil {
+		return token, sdkerrors.Wrapf(err, "max supply of %s", token.Symbol)
+	}
+	maxBalance, err := rescaleLimit(token.MaxBalancePerHolder, num, den)
+	if err != nil {
+		return token, sdkerrors.Wrapf(err, "max balance per holder of %s", token.Symbol)
+	}
+
+	// holders are ordered by address, which the stable sort keeps on ties
+	order := make([]int, len(holders))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].GT(remainders[order[b]])
+	})
+	leftover := sum.Mul(num).Quo(den).Sub(rescaled)
+	for _, i := range order {
+		if !leftover.IsPositive() {
+			break
+		}
+		after[i] = after[i].AddRaw(1)
+		leftover = leftover.SubRaw(1)
+	}
+
+	// the split moves balances regardless of the token restrictions
+	bypassCtx := withRestrictionBypass(ctx)
+
+	burnt, minted := math.ZeroInt(), math.ZeroInt()
+	for i, holder := range holders {
+		if diff := before[i].Sub(after[i]); diff.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(denom, diff))
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(bypassCtx, holder, types.ModuleName, coins); err != nil {
+				return token, err
+			}
+			burnt = burnt.Add(diff)
+		}
+	}
+	if burnt.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, burnt))); err != nil {
+			return token, err
+		}
+		k.trackSnapshotSupply(ctx, token, burnt.Neg())
+	}
+
+	for i := range holders {
+		if diff := after[i].Sub(before[i]); diff.IsPositive() {
+			minted = minted.Add(diff)
+		}
+	}
+	if minted.IsPositive() {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, minted))); err != nil {
+			return token, err
+		}
+		k.trackSnapshotSupply(ctx, token, minted)
+	}
+	for i, holder := range holders {
+		if diff := after[i].Sub(before[i]); diff.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(denom, diff))
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(bypassCtx, types.ModuleName, holder, coins); err != nil {
+				return token, err
+			}
+		}
+	}
+	k.syncERC20Supply(ctx, token)
+
+	for i, holder := range holders {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSplitBalance,
+				sdk.NewAttribute(types.AttributeKeySymbol, token.Symbol),
+				sdk.NewAttribute(types.AttributeKeyAddress, holder.String()),
+				sdk.NewAttribute(types.AttributeKeyBalanceBefore, before[i].String()),
+				sdk.NewAttribute(types.AttributeKeyBalanceAfter, after[i].String()),
+			),
+		)
+	}
+
+	token.Total = total.Quo(unit).String()
+	token.MaxSupply = maxSupply
+	token.MaxBalancePerHolder = maxBalance
+	k.SetToken(ctx, token)
+
+	return token, nil
+}
+
+// rescaleLimit rescales a limit of a token in whole units by num/den, an empty
+// limit stays unset
+func rescaleLimit(limit string, num, den math.Int) (string, error) {
+	if limit == "" {
+		return limit, nil
+	}
+	amount, ok := math.NewIntFromString(limit)
+	if !ok {
+		return limit, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid limit %s", limit)
+	}
+	scaled := amount.Mul(num)
+	if !scaled.Mod(den).IsZero() {
+		return limit, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s does not split into whole tokens", limit)
+	}
+	return scaled.Quo(den).String(), nil
+}
@@ -1,10 +1,16 @@
 package keeper
 
 import (
+	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	"github.com/realiotech/realio-network/x/asset/types"
 )
 
@@ -16,6 +22,8 @@ func (k Keeper) SetToken(ctx sdk.Context, token types.Token) {
 	store.Set(types.TokenKey(
 		lowerCased,
 	), b)
+
+	k.setAssetRegistryToken(ctx, token)
 }
 
 // GetToken returns a token from its symbol
@@ -52,17 +60,55 @@ func (k Keeper) GetAllToken(ctx sdk.Context) (list []types.Token) {
 	return
 }
 
-func (k Keeper) IsAddressAuthorizedToSend(ctx sdk.Context, symbol string, address sdk.AccAddress) (authorized bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TokenKeyPrefix))
-	lowerCased := strings.ToLower(symbol)
-	b := store.Get(types.TokenKey(
-		lowerCased,
-	))
-	if b == nil {
-		return false
+// burnTokens burns amount whole units of the token held by the module account
+// and reduces the token total accordingly.
+func (k Keeper) burnTokens(ctx sdk.Context, token types.Token, amount math.Int) error {
+	total, isValid := math.NewIntFromString(token.Total)
+	if !isValid {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid token total %s", token.Total)
+	}
+	if amount.GT(total) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "burn amount %s exceeds token total %s", amount, token.Total)
 	}
-	var t types.Token
-	k.cdc.MustUnmarshal(b, &t)
 
-	return t.AddressIsAuthorized(address)
+	coins := tokenCoins(token, amount)
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	k.syncERC20Supply(ctx, token)
+	k.trackSnapshotSupply(ctx, token, coins.AmountOf(tokenDenom(token)).Neg())
+
+	token.Total = total.Sub(amount).String()
+	k.SetToken(ctx, token)
+
+	return nil
+}
+
+// tokenCoins converts amount whole units of the token into its base denom,
+// normalized into the token's 10^decimals denomination.
+func tokenCoins(token types.Token, amount math.Int) sdk.Coins {
+	return sdk.Coins{{Denom: tokenDenom(token), Amount: token.BaseUnits(amount)}}
+}
+
+// tokenDenom returns the base denom of the token.
+func tokenDenom(token types.Token) string {
+	return fmt.Sprintf("a%s", strings.ToLower(token.Symbol))
+}
+
+// setDenomMetadata registers the bank metadata of the token denom, displayed
+// in whole units when the token has decimals.
+func (k Keeper) setDenomMetadata(ctx sdk.Context, token types.Token) {
+	baseDenom := tokenDenom(token)
+	lowerCaseSymbol := strings.ToLower(token.Symbol)
+	denomUnits := []*bank.DenomUnit{{Denom: baseDenom, Exponent: 0}}
+	display := baseDenom
+	if token.Decimals > 0 {
+		denomUnits = append(denomUnits, &bank.DenomUnit{Denom: lowerCaseSymbol, Exponent: token.Decimals})
+		display = lowerCaseSymbol
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, bank.Metadata{
+		Base: baseDenom, Symbol: lowerCaseSymbol, Name: strings.ToLower(token.Name), Display: display,
+		Description: token.Description, URI: token.Uri, URIHash: token.UriHash,
+		DenomUnits: denomUnits,
+	})
 }
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// TransmitTokenDefinitionPacket sends the definition and compliance data of a
+// token issued on this chain over an asset channel
+func (k Keeper) TransmitTokenDefinitionPacket(ctx sdk.Context, token types.Token, sourceChannel string, timeoutTimestamp uint64) (uint64, error) {
+	packetData := types.TokenDefinitionPacketData{
+		Token:          token,
+		Authorizations: k.GetTokenAuthorizations(ctx, token.Symbol),
+		Frozen:         k.GetTokenFrozenAddresses(ctx, token.Symbol),
+		Denied:         k.GetTokenDeniedAddresses(ctx, token.Symbol),
+		Jurisdictions:  k.GetTokenAddressJurisdictions(ctx, token.Symbol),
+	}
+	// the pending manager can only accept on this chain
+	packetData.Token.PendingManager = ""
+
+	return k.transmitPacket(ctx, types.AssetPacketData{
+		Packet: &types.AssetPacketData_TokenDefinition{TokenDefinition: &packetData},
+	}, sourceChannel, timeoutTimestamp)
+}
+
+// OnRecvTokenDefinitionPacket creates or updates the replica of a token issued
+// on the counterparty chain. The compliance data of the replica is replaced
+// by the one of the packet, the issuing chain is authoritative for it. Only
+// the replica channels approved by governance can register symbols.
+func (k Keeper) OnRecvTokenDefinitionPacket(ctx sdk.Context, packet channeltypes.Packet, data types.TokenDefinitionPacketData) (packetAck types.TokenDefinitionPacketAck, err error) {
+	if !k.GetParams(ctx).IsReplicaChannel(packet.DestinationChannel) {
+		return packetAck, sdkerrors.Wrapf(types.ErrChannelNotApproved, "%s cannot replicate tokens", packet.DestinationChannel)
+	}
+
+	token := data.Token
+	symbol := strings.ToLower(token.Symbol)
+	if err := types.ValidateSymbol(token.Symbol); err != nil {
+		return packetAck, err
+	}
+	if _, err := sdk.AccAddressFromBech32(token.Manager); err != nil {
+		return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid manager address: %s", err)
+	}
+	// the rules are checked on every transfer of the replica, so they have to
+	// be known on this chain as well
+	for _, config := range token.ComplianceRules {
+		rule, found := k.GetComplianceRule(config.Id)
+		if !found {
+			return packetAck, sdkerrors.Wrapf(types.ErrUnknownComplianceRule, "%s enables unknown rule %s", symbol, config.Id)
+		}
+		if err := rule.ValidateParams(config.Params); err != nil {
+			return packetAck, sdkerrors.Wrapf(err, "rule %s", config.Id)
+		}
+	}
+
+	existing, found := k.GetToken(ctx, symbol)
+	if found && existing.OriginChannel != packet.DestinationChannel {
+		return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "symbol %s already set", symbol)
+	}
+
+	token.Symbol = symbol
+	token.OriginChannel = packet.DestinationChannel
+	k.SetToken(ctx, token)
+	if !found {
+		k.setDenomMetadata(ctx, token)
+	}
+	if err := k.deployERC20Facade(ctx, token); err != nil {
+		return packetAck, err
+	}
+
+	for _, authorization := range k.GetTokenAuthorizations(ctx, symbol) {
+		k.RemoveAuthorization(ctx, symbol, authorization.Address)
+	}
+	for _, frozen := range k.GetTokenFrozenAddresses(ctx, symbol) {
+		k.RemoveFrozenAddress(ctx, symbol, frozen.Address)
+	}
+	for _, denied := range k.GetTokenDeniedAddresses(ctx, symbol) {
+		k.RemoveDeniedAddress(ctx, symbol, denied.Address)
+	}
+	for _, jurisdiction := range k.GetTokenAddressJurisdictions(ctx, symbol) {
+		k.RemoveAddressJurisdiction(ctx, symbol, jurisdiction.Address)
+	}
+
+	for _, authorization := range data.Authorizations {
+		if _, err := sdk.AccAddressFromBech32(authorization.Address); err != nil {
+			return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authorized address: %s", err)
+		}
+		authorization.Symbol = symbol
+		k.SetAuthorization(ctx, authorization)
+	}
+	for _, frozen := range data.Frozen {
+		if _, err := sdk.AccAddressFromBech32(frozen.Address); err != nil {
+			return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid frozen address: %s", err)
+		}
+		k.SetFrozenAddress(ctx, types.NewFrozenAddress(symbol, frozen.Address))
+	}
+	for _, denied := range data.Denied {
+		if _, err := sdk.AccAddressFromBech32(denied.Address); err != nil {
+			return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid denied address: %s", err)
+		}
+		k.SetDeniedAddress(ctx, types.NewDeniedAddress(symbol, denied.Address))
+	}
+	for _, jurisdiction := range data.Jurisdictions {
+		if _, err := sdk.AccAddressFromBech32(jurisdiction.Address); err != nil {
+			return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid jurisdiction address: %s", err)
+		}
+		jurisdiction.Symbol = symbol
+		k.SetAddressJurisdiction(ctx, jurisdiction)
+	}
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementTokenDefinitionPacket responds to the success or failure of
+// a packet acknowledgement written on the receiving chain. Nothing is changed
+// on the issuing chain either way, the definition can be sent again.
+func (k Keeper) OnAcknowledgementTokenDefinitionPacket(ctx sdk.Context, packet channeltypes.Packet, data types.TokenDefinitionPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.TokenDefinitionPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, "cannot unmarshal acknowledgment")
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid acknowledgment format: %T", dispatchedAck)
+	}
+}
+
+// OnTimeoutTokenDefinitionPacket responds to the case where a packet has not
+// been transmitted because of a timeout
+func (k Keeper) OnTimeoutTokenDefinitionPacket(ctx sdk.Context, packet channeltypes.Packet, data types.TokenDefinitionPacketData) error {
+	return nil
+}
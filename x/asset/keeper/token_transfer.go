@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// TransmitTokenTransferPacket moves amount base units of a token from sender
+// to receiver on the counterparty chain of an asset channel. Tokens issued on
+// this chain are escrowed, replicas are burnt and can only go back to their
+// issuing chain. The send is checked like a transfer between the same
+// accounts on this chain, and the packet carries the compliance data of the
+// receiver for the counterparty chain to enforce.
+func (k Keeper) TransmitTokenTransferPacket(
+	ctx sdk.Context,
+	token types.Token,
+	sender, receiver sdk.AccAddress,
+	amount math.Int,
+	sourceChannel string,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	if token.OriginChannel != "" && token.OriginChannel != sourceChannel {
+		return 0, sdkerrors.Wrapf(types.ErrReplicatedToken, "%s can only be sent back over %s", token.Symbol, token.OriginChannel)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(tokenDenom(token), amount))
+	transfer := types.ComplianceTransfer{From: sender, To: receiver, Coin: coins[0]}
+	if err := k.checkTransfer(ctx, token, transfer); err != nil {
+		return 0, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+	if token.OriginChannel == "" {
+		k.addChannelEscrow(ctx, sourceChannel, token.Symbol, amount)
+	} else {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return 0, err
+		}
+		k.syncERC20Supply(ctx, token)
+		k.trackSnapshotSupply(ctx, token, coins.AmountOf(tokenDenom(token)).Neg())
+	}
+
+	packetData := types.TokenTransferPacketData{
+		Symbol:               token.Symbol,
+		Amount:               amount.String(),
+		Sender:               sender.String(),
+		Receiver:             receiver.String(),
+		RestrictionMode:      token.EffectiveRestrictionMode(),
+		ReceiverAuthorized:   k.IsAddressAuthorizedToSend(ctx, token.Symbol, receiver),
+		ReceiverJurisdiction: k.GetJurisdiction(ctx, token.Symbol, receiver),
+	}
+
+	return k.transmitPacket(ctx, types.AssetPacketData{
+		Packet: &types.AssetPacketData_TokenTransfer{TokenTransfer: &packetData},
+	}, sourceChannel, timeoutTimestamp)
+}
+
+// OnRecvTokenTransferPacket releases the units of a token received over an
+// asset channel to the receiver. On top of the checks of this chain, the
+// allowlist of the sending chain applies.
+func (k Keeper) OnRecvTokenTransferPacket(ctx sdk.Context, packet channeltypes.Packet, data types.TokenTransferPacketData) (packetAck types.TokenTransferPacketAck, err error) {
+	token, found := k.GetToken(ctx, data.Symbol)
+	if !found {
+		return packetAck, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "token %s not found", data.Symbol)
+	}
+	if token.OriginChannel != "" && token.OriginChannel != packet.DestinationChannel {
+		return packetAck, sdkerrors.Wrapf(types.ErrReplicatedToken, "%s can only be received over %s", token.Symbol, token.OriginChannel)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return packetAck, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", data.Amount)
+	}
+
+	if data.RestrictionMode == types.RestrictionModeAllowlist && !data.ReceiverAuthorized {
+		return packetAck, sdkerrors.Wrapf(types.ErrNotAuthorized, "%s is not authorized for %s on the sending chain", data.Receiver, token.Symbol)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(tokenDenom(token), amount))
+	transfer := types.ComplianceTransfer{From: sender, To: receiver, Coin: coins[0]}
+	if err := k.checkTransfer(ctx, token, transfer); err != nil {
+		return packetAck, err
+	}
+
+	if token.OriginChannel == "" {
+		if err := k.subChannelEscrow(ctx, packet.DestinationChannel, token.Symbol, amount); err != nil {
+			return packetAck, err
+		}
+	} else {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return packetAck, err
+		}
+		k.syncERC20Supply(ctx, token)
+		k.trackSnapshotSupply(ctx, token, coins.AmountOf(tokenDenom(token)))
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return packetAck, err
+	}
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementTokenTransferPacket responds to the success or failure of
+// a packet acknowledgement written on the receiving chain, the sender is
+// refunded on failure
+func (k Keeper) OnAcknowledgementTokenTransferPacket(ctx sdk.Context, packet channeltypes.Packet, data types.TokenTransferPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundTokenTransfer(ctx, packet, data)
+	case *channeltypes.Acknowledgement_Result:
+		// Decode the packet acknowledgment
+		var packetAck types.TokenTransferPacketAck
+
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			// The counter-party module doesn't implement the correct acknowledgment format
+			return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, "cannot unmarshal acknowledgment")
+		}
+
+		return nil
+	default:
+		// The counter-party module doesn't implement the correct acknowledgment format
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid acknowledgment format: %T", dispatchedAck)
+	}
+}
+
+// OnTimeoutTokenTransferPacket responds to the case where a packet has not
+// been transmitted because of a timeout, the sender is refunded
+func (k Keeper) OnTimeoutTokenTransferPacket(ctx sdk.Context, packet channeltypes.Packet, data types.TokenTransferPacketData) error {
+	return k.refundTokenTransfer(ctx, packet, data)
+}
+
+// refundTokenTransfer gives the sender back the units of a transfer that did
+// not reach the counterparty chain. Refunds bypass the restrictions, the
+// units never left the sender as far as the token is concerned.
+func (k Keeper) refundTokenTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.TokenTransferPacketData) error {
+	token, found := k.GetToken(ctx, data.Symbol)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "token %s not found", data.Symbol)
+	}
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", data.Amount)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(tokenDenom(token), amount))
+	if token.OriginChannel == "" {
+		if err := k.subChannelEscrow(ctx, packet.SourceChannel, token.Symbol, amount); err != nil {
+			return err
+		}
+	} else {
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		k.syncERC20Supply(ctx, token)
+		k.trackSnapshotSupply(ctx, token, coins.AmountOf(tokenDenom(token)))
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(withRestrictionBypass(ctx), types.ModuleName, sender, coins)
+}
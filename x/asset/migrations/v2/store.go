@@ -0,0 +1,58 @@
+package v2
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2 of the tokens.
+// Tokens created before decimals were configurable were always minted with 18
+// decimals, so the migration records that exponent on every existing token. The
+// authorizations kept inside each token are moved to their own store, indexed
+// by symbol/address and address/symbol, and cleared from the token.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	tokenStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.TokenKeyPrefix))
+	authorizationStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.AuthorizationKeyPrefix))
+	indexStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.AddressAuthorizationKeyPrefix))
+
+	iterator := sdk.KVStorePrefixIterator(tokenStore, []byte{})
+
+	// writing to the token store while iterating over it is not safe, so the
+	// tokens are rewritten once the iteration is done
+	var keys [][]byte
+	var tokens []types.Token
+	for ; iterator.Valid(); iterator.Next() {
+		var token types.Token
+		if err := cdc.Unmarshal(iterator.Value(), &token); err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		tokens = append(tokens, token)
+	}
+	iterator.Close()
+
+	for i, token := range tokens {
+		lowerCased := strings.ToLower(token.Symbol)
+		for _, a := range token.Authorized {
+			if a == nil || !a.Authorized {
+				continue
+			}
+			authorization := types.TokenAuthorization{Symbol: lowerCased, Address: a.Address, Authorized: true}
+			authorizationStore.Set(types.AuthorizationKey(lowerCased, a.Address), cdc.MustMarshal(&authorization))
+			indexStore.Set(types.AddressAuthorizationKey(a.Address, lowerCased), []byte{})
+		}
+
+		token.Decimals = types.MaxDecimals
+		token.Authorized = nil
+		tokenStore.Set(keys[i], cdc.MustMarshal(&token))
+	}
+
+	return nil
+}
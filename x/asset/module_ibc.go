@@ -0,0 +1,287 @@
+package asset
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v6/modules/core/exported"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS26 interface for the asset ibc application,
+// which replicates tokens and moves them between Realio based chains
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// validateChannelParams checks the channel is unordered and bound to the asset port
+func (im IBCModule) validateChannelParams(ctx sdk.Context, order channeltypes.Order, portID string) error {
+	if order != channeltypes.UNORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	}
+
+	if boundPort := im.keeper.GetPort(ctx); boundPort != portID {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := im.validateChannelParams(ctx, order, portID); err != nil {
+		return "", err
+	}
+
+	if version == "" {
+		version = types.Version
+	}
+	if version != types.Version {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	}
+
+	// Claim channel capability passed back by IBC module
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := im.validateChannelParams(ctx, order, portID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != types.Version {
+		return "", sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	}
+
+	// Module may have already claimed capability in OnChanOpenInit in the case of crossing hellos
+	// (ie chainA and chainB both call ChanOpenInit before one of them calls ChanOpenTry)
+	// If module can already authenticate the capability then module already owns it so we don't need to claim
+	// Otherwise, module does not have channel capability and we must claim it from IBC
+	if !im.keeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		// Only claim channel capability passed back by IBC module if we do not already own it
+		if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+			return "", err
+		}
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	_,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: %s, expected %s", counterpartyVersion, types.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	// Disallow user-initiated channel closing for asset channels, replicas
+	// and escrowed tokens depend on them
+	return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var modulePacketData types.AssetPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(modulePacket.GetData(), &modulePacketData); err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error()))
+	}
+
+	var (
+		ack        channeltypes.Acknowledgement
+		event      sdk.Event
+		packetAck  proto.Message
+		err        error
+		attributes []sdk.Attribute
+	)
+
+	// Dispatch packet
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.AssetPacketData_TokenDefinition:
+		var definitionAck types.TokenDefinitionPacketAck
+		definitionAck, err = im.keeper.OnRecvTokenDefinitionPacket(ctx, modulePacket, *packet.TokenDefinition)
+		packetAck = &definitionAck
+		event = sdk.NewEvent(
+			types.EventTypeTokenReplicated,
+			sdk.NewAttribute(types.AttributeKeySymbol, packet.TokenDefinition.Token.Symbol),
+			sdk.NewAttribute(types.AttributeKeyChannel, modulePacket.DestinationChannel),
+		)
+	case *types.AssetPacketData_TokenTransfer:
+		var transferAck types.TokenTransferPacketAck
+		transferAck, err = im.keeper.OnRecvTokenTransferPacket(ctx, modulePacket, *packet.TokenTransfer)
+		packetAck = &transferAck
+		event = sdk.NewEvent(
+			types.EventTypeTokenReceived,
+			sdk.NewAttribute(types.AttributeKeySymbol, packet.TokenTransfer.Symbol),
+			sdk.NewAttribute(types.AttributeKeyFrom, packet.TokenTransfer.Sender),
+			sdk.NewAttribute(types.AttributeKeyTo, packet.TokenTransfer.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, packet.TokenTransfer.Amount),
+			sdk.NewAttribute(types.AttributeKeyChannel, modulePacket.DestinationChannel),
+		)
+	default:
+		err := fmt.Errorf("unrecognized %s packet type: %T", types.ModuleName, packet)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if err != nil {
+		ack = channeltypes.NewErrorAcknowledgement(err)
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyAckError, err.Error()))
+	} else {
+		// Encode packet acknowledgment
+		packetAckBytes, err := types.ModuleCdc.MarshalJSON(packetAck)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+		}
+		ack = channeltypes.NewResultAcknowledgement(sdk.MustSortJSON(packetAckBytes))
+	}
+
+	ctx.EventManager().EmitEvent(event.AppendAttributes(attributes...))
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet acknowledgement: %v", err)
+	}
+
+	var modulePacketData types.AssetPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(modulePacket.GetData(), &modulePacketData); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+
+	// Dispatch packet
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.AssetPacketData_TokenDefinition:
+		return im.keeper.OnAcknowledgementTokenDefinitionPacket(ctx, modulePacket, *packet.TokenDefinition, ack)
+	case *types.AssetPacketData_TokenTransfer:
+		if err := im.keeper.OnAcknowledgementTokenTransferPacket(ctx, modulePacket, *packet.TokenTransfer, ack); err != nil {
+			return err
+		}
+		if !ack.Success() {
+			im.emitRefundEvent(ctx, *packet.TokenTransfer)
+		}
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s packet type: %T", types.ModuleName, packet)
+	}
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	modulePacket channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	var modulePacketData types.AssetPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(modulePacket.GetData(), &modulePacketData); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal packet data: %s", err.Error())
+	}
+
+	// Dispatch packet
+	switch packet := modulePacketData.Packet.(type) {
+	case *types.AssetPacketData_TokenDefinition:
+		return im.keeper.OnTimeoutTokenDefinitionPacket(ctx, modulePacket, *packet.TokenDefinition)
+	case *types.AssetPacketData_TokenTransfer:
+		if err := im.keeper.OnTimeoutTokenTransferPacket(ctx, modulePacket, *packet.TokenTransfer); err != nil {
+			return err
+		}
+		im.emitRefundEvent(ctx, *packet.TokenTransfer)
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s packet type: %T", types.ModuleName, packet)
+	}
+}
+
+func (im IBCModule) emitRefundEvent(ctx sdk.Context, data types.TokenTransferPacketData) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenRefunded,
+			sdk.NewAttribute(types.AttributeKeySymbol, data.Symbol),
+			sdk.NewAttribute(types.AttributeKeyTo, data.Sender),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, data.Amount),
+		),
+	)
+}
@@ -0,0 +1,155 @@
+package asset_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/realiotech/realio-network/app"
+	"github.com/realiotech/realio-network/testutil"
+	"github.com/realiotech/realio-network/x/asset/keeper"
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+type IBCTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func TestIBCTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCTestSuite))
+}
+
+func (suite *IBCTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = app.SetupTestingApp
+
+	// the evm requires ethermint chain ids, the chains are built by hand
+	suite.coordinator = &ibctesting.Coordinator{
+		T:           suite.T(),
+		CurrentTime: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+		Chains:      map[string]*ibctesting.TestChain{},
+	}
+	for _, chainID := range []string{"realionetwork_3301-1", "realionetwork_3302-1"} {
+		suite.coordinator.Chains[chainID] = ibctesting.NewTestChain(suite.T(), suite.coordinator, chainID)
+	}
+	suite.chainA = suite.coordinator.GetChain("realionetwork_3301-1")
+	suite.chainB = suite.coordinator.GetChain("realionetwork_3302-1")
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = types.PortID
+	suite.path.EndpointB.ChannelConfig.PortID = types.PortID
+	suite.path.EndpointA.ChannelConfig.Version = types.Version
+	suite.path.EndpointB.ChannelConfig.Version = types.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+func (suite *IBCTestSuite) realio(chain *ibctesting.TestChain) *app.RealioNetwork {
+	return chain.App.(*app.RealioNetwork)
+}
+
+func (suite *IBCTestSuite) timeout(chain *ibctesting.TestChain) uint64 {
+	return uint64(chain.CurrentHeader.Time.Add(time.Hour).UnixNano())
+}
+
+// sendAndRelay delivers msg on the chain and relays the packet it sends
+func (suite *IBCTestSuite) sendAndRelay(chain *ibctesting.TestChain, msg sdk.Msg) {
+	res, err := chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+}
+
+func (suite *IBCTestSuite) balance(chain *ibctesting.TestChain, addr sdk.AccAddress) math.Int {
+	return suite.realio(chain).BankKeeper.GetBalance(chain.GetContext(), addr, "arst").Amount
+}
+
+func (suite *IBCTestSuite) TestTokenReplicationAndTransfer() {
+	manager := suite.chainA.SenderAccount.GetAddress()
+	holder := suite.chainB.SenderAccount.GetAddress()
+	channelA := suite.path.EndpointA.ChannelID
+	channelB := suite.path.EndpointB.ChannelID
+
+	_, err := suite.chainA.SendMsgs(&types.MsgCreateToken{Manager: manager.String(), Name: "realio security token", Symbol: "RST", Total: "1000", AuthorizationRequired: true})
+	suite.Require().NoError(err)
+	_, err = suite.chainA.SendMsgs(types.NewMsgAuthorizeAddress(manager.String(), "RST", holder.String()))
+	suite.Require().NoError(err)
+
+	// chain B only accepts token definitions from the channels governance approved
+	suite.sendAndRelay(suite.chainA, types.NewMsgReplicateToken(manager.String(), "RST", channelA, suite.timeout(suite.chainB)))
+	_, found := suite.realio(suite.chainB).AssetKeeper.GetToken(suite.chainB.GetContext(), "RST")
+	suite.Require().False(found)
+	suite.realio(suite.chainB).AssetKeeper.SetParams(suite.chainB.GetContext(), types.NewParams([]string{channelB}))
+
+	// the token and its authorizations are replicated on chain B
+	suite.sendAndRelay(suite.chainA, types.NewMsgReplicateToken(manager.String(), "RST", channelA, suite.timeout(suite.chainB)))
+	replica, found := suite.realio(suite.chainB).AssetKeeper.GetToken(suite.chainB.GetContext(), "RST")
+	suite.Require().True(found)
+	suite.Require().Equal(channelB, replica.OriginChannel)
+	suite.Require().Equal(types.RestrictionModeAllowlist, replica.EffectiveRestrictionMode())
+	suite.Require().True(suite.realio(suite.chainB).AssetKeeper.IsAddressAuthorizedToSend(suite.chainB.GetContext(), "RST", holder))
+
+	// replicas can not be minted on chain B
+	srv := keeper.NewMsgServerImpl(suite.realio(suite.chainB).AssetKeeper)
+	_, err = srv.MintToken(sdk.WrapSDKContext(suite.chainB.GetContext()), types.NewMsgMintToken(manager.String(), "RST", holder.String(), "1"))
+	suite.Require().ErrorIs(err, types.ErrReplicatedToken)
+
+	// nor have their compliance data changed there
+	ctxB := sdk.WrapSDKContext(suite.chainB.GetContext())
+	_, err = srv.AuthorizeAddress(ctxB, types.NewMsgAuthorizeAddress(manager.String(), "RST", testutil.GenAddress().String()))
+	suite.Require().ErrorIs(err, types.ErrReplicatedToken)
+	_, err = srv.FreezeAddress(ctxB, types.NewMsgFreezeAddress(manager.String(), "RST", holder.String()))
+	suite.Require().ErrorIs(err, types.ErrReplicatedToken)
+	_, err = srv.PauseToken(ctxB, types.NewMsgPauseToken(manager.String(), "RST"))
+	suite.Require().ErrorIs(err, types.ErrReplicatedToken)
+
+	// tokens sent to chain B are escrowed on chain A and minted on chain B
+	managerBalance := suite.balance(suite.chainA, manager)
+	suite.sendAndRelay(suite.chainA, types.NewMsgSendToken(manager.String(), "RST", "100", holder.String(), channelA, suite.timeout(suite.chainB)))
+	suite.Require().Equal(managerBalance.SubRaw(100), suite.balance(suite.chainA, manager))
+	suite.Require().Equal(math.NewInt(100), suite.realio(suite.chainA).AssetKeeper.GetChannelEscrow(suite.chainA.GetContext(), channelA, "RST"))
+	suite.Require().Equal(math.NewInt(100), suite.balance(suite.chainB, holder))
+
+	// a receiver not authorized on chain B is refunded on chain A
+	unauthorized := testutil.GenAddress().String()
+	_, err = suite.chainA.SendMsgs(types.NewMsgAuthorizeAddress(manager.String(), "RST", unauthorized))
+	suite.Require().NoError(err)
+	suite.sendAndRelay(suite.chainA, types.NewMsgSendToken(manager.String(), "RST", "10", unauthorized, channelA, suite.timeout(suite.chainB)))
+	suite.Require().Equal(managerBalance.SubRaw(100), suite.balance(suite.chainA, manager))
+	suite.Require().Equal(math.NewInt(100), suite.realio(suite.chainA).AssetKeeper.GetChannelEscrow(suite.chainA.GetContext(), channelA, "RST"))
+
+	// the units on chain B can not be taken back there
+	_, err = srv.ForceTransfer(sdk.WrapSDKContext(suite.chainB.GetContext()), types.NewMsgForceTransfer(manager.String(), "RST", holder.String(), manager.String(), "10", "clawback"))
+	suite.Require().ErrorIs(err, types.ErrReplicatedToken)
+
+	// tokens sent back are burnt on chain B and released from the escrow on chain A
+	suite.sendAndRelay(suite.chainB, types.NewMsgSendToken(holder.String(), "RST", "40", manager.String(), channelB, suite.timeout(suite.chainA)))
+	suite.Require().Equal(math.NewInt(60), suite.balance(suite.chainB, holder))
+	suite.Require().Equal(managerBalance.SubRaw(60), suite.balance(suite.chainA, manager))
+	suite.Require().Equal(math.NewInt(60), suite.realio(suite.chainA).AssetKeeper.GetChannelEscrow(suite.chainA.GetContext(), channelA, "RST"))
+}
+
+func (suite *IBCTestSuite) TestTokenDefinitionUnknownComplianceRule() {
+	channelB := suite.path.EndpointB.ChannelID
+	keeperB := suite.realio(suite.chainB).AssetKeeper
+	keeperB.SetParams(suite.chainB.GetContext(), types.NewParams([]string{channelB}))
+
+	token := types.NewToken("realio security token", "RST", "1000", suite.chainA.SenderAccount.GetAddress().String(), false)
+	token.ComplianceRules = []types.ComplianceRuleConfig{{Id: "unknown"}}
+	packet := channeltypes.Packet{DestinationPort: types.PortID, DestinationChannel: channelB}
+
+	// chain B could not check the transfers of the replica
+	_, err := keeperB.OnRecvTokenDefinitionPacket(suite.chainB.GetContext(), packet, types.TokenDefinitionPacketData{Token: token})
+	suite.Require().ErrorIs(err, types.ErrUnknownComplianceRule)
+	_, found := keeperB.GetToken(suite.chainB.GetContext(), "RST")
+	suite.Require().False(found)
+}
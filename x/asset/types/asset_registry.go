@@ -0,0 +1,309 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// assetRegistryABIJSON is the interface of the asset registry
+const assetRegistryABIJSON = `[
+	{"type":"function","name":"isAuthorized","stateMutability":"view","inputs":[{"name":"symbol","type":"string"},{"name":"account","type":"address"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"getToken","stateMutability":"view","inputs":[{"name":"symbol","type":"string"}],"outputs":[{"name":"manager","type":"address"},{"name":"erc20","type":"address"},{"name":"decimals","type":"uint8"},{"name":"paused","type":"bool"},{"name":"restrictionMode","type":"uint8"}]},
+	{"type":"function","name":"authorize","stateMutability":"nonpayable","inputs":[{"name":"symbol","type":"string"},{"name":"account","type":"address"}],"outputs":[]},
+	{"type":"function","name":"unauthorize","stateMutability":"nonpayable","inputs":[{"name":"symbol","type":"string"},{"name":"account","type":"address"}],"outputs":[]},
+	{"type":"event","name":"Authorize","anonymous":false,"inputs":[{"name":"caller","type":"address","indexed":true},{"name":"account","type":"address","indexed":true},{"name":"symbol","type":"string","indexed":false}]},
+	{"type":"event","name":"Unauthorize","anonymous":false,"inputs":[{"name":"caller","type":"address","indexed":true},{"name":"account","type":"address","indexed":true},{"name":"symbol","type":"string","indexed":false}]}
+]`
+
+// AssetRegistryAsm is the source of AssetRegistryCode, in the assembly of the
+// go-ethereum asm package. The views are served from the registry storage,
+// which the keeper keeps equal to the tokens and authorizations of the module.
+// Symbols are matched regardless of their case. authorize and unauthorize only
+// emit their log with the caller, which the EVM hooks apply after the
+// transaction when the caller may authorize addresses for the token. Calls
+// with a value revert.
+const AssetRegistryAsm = `
+	PUSH 0x04
+	CALLDATASIZE
+	LT
+	JUMPI @fail
+	CALLVALUE
+	JUMPI @fail
+	PUSH 0x00
+	CALLDATALOAD
+	PUSH 0xe0
+	SHR
+	DUP1
+	PUSH 0xae1e8af9
+	EQ
+	JUMPI @is_authorized
+	DUP1
+	PUSH 0xc1733f68
+	EQ
+	JUMPI @get_token
+	DUP1
+	PUSH 0x86457702
+	EQ
+	JUMPI @authorize
+	DUP1
+	PUSH 0x2c5054d4
+	EQ
+	JUMPI @unauthorize
+fail:
+	PUSH 0x00
+	DUP1
+	REVERT
+
+;; isAuthorized(string symbol, address account)
+is_authorized:
+	PUSH 0x44
+	CALLDATASIZE
+	LT
+	JUMPI @fail
+	PUSH 0x24
+	CALLDATALOAD
+	DUP1
+	PUSH 0xa0
+	SHR
+	JUMPI @fail
+	PUSH @is_authorized_hashed
+	JUMP @symbol_hash
+is_authorized_hashed:
+	;; the expiry of the authorization, in the mapping at slot 1
+	PUSH 0x00
+	MSTORE
+	PUSH 0x01
+	PUSH 0x20
+	MSTORE
+	PUSH 0x40
+	PUSH 0x00
+	KECCAK256
+	PUSH 0x20
+	MSTORE
+	PUSH 0x00
+	MSTORE
+	PUSH 0x40
+	PUSH 0x00
+	KECCAK256
+	SLOAD
+	TIMESTAMP
+	LT
+	PUSH 0x00
+	MSTORE
+	PUSH 0x20
+	PUSH 0x00
+	RETURN
+
+;; getToken(string symbol)
+get_token:
+	PUSH 0x24
+	CALLDATASIZE
+	LT
+	JUMPI @fail
+	PUSH @get_token_hashed
+	JUMP @symbol_hash
+get_token_hashed:
+	;; the token, in the mapping at slot 0
+	PUSH 0x00
+	MSTORE
+	PUSH 0x00
+	PUSH 0x20
+	MSTORE
+	PUSH 0x40
+	PUSH 0x00
+	KECCAK256
+	DUP1
+	SLOAD
+	PUSH 0x00
+	MSTORE
+	DUP1
+	PUSH 0x01
+	ADD
+	SLOAD
+	PUSH 0x20
+	MSTORE
+	DUP1
+	PUSH 0x02
+	ADD
+	SLOAD
+	PUSH 0x40
+	MSTORE
+	DUP1
+	PUSH 0x03
+	ADD
+	SLOAD
+	PUSH 0x60
+	MSTORE
+	PUSH 0x04
+	ADD
+	SLOAD
+	PUSH 0x80
+	MSTORE
+	PUSH 0xa0
+	PUSH 0x00
+	RETURN
+
+;; authorize(string symbol, address account)
+authorize:
+	PUSH 0x8a6fa22cbcd96428dae964201b351427af5a5a55c3239abdbe74b8771906cd97
+	JUMP @emit
+;; unauthorize(string symbol, address account)
+unauthorize:
+	PUSH 0xdb5891cbd3c619fa9d603abd6f58cd405836acbc533bc170fe93bfb2474594e0
+;; emits the event on the stack with the caller, the account and the symbol
+emit:
+	PUSH 0x44
+	CALLDATASIZE
+	LT
+	JUMPI @fail
+	PUSH 0x24
+	CALLDATALOAD
+	DUP1
+	PUSH 0xa0
+	SHR
+	JUMPI @fail
+	PUSH @emit_copied
+	JUMP @copy_symbol
+emit_copied:
+	PUSH 0x20
+	PUSH 0x00
+	MSTORE
+	DUP1
+	PUSH 0x20
+	MSTORE
+	PUSH 0x1f
+	ADD
+	PUSH 0x1f
+	NOT
+	AND
+	PUSH 0x40
+	ADD
+	SWAP1
+	CALLER
+	DUP4
+	DUP4
+	PUSH 0x00
+	LOG3
+	STOP
+
+;; copies the symbol argument to memory from 0x40 on and returns its length
+;; to the address on the stack
+copy_symbol:
+	PUSH 0x04
+	CALLDATALOAD
+	PUSH 0x04
+	ADD
+	DUP1
+	CALLDATALOAD
+	DUP1
+	PUSH 0x7f
+	LT
+	JUMPI @fail
+	DUP1
+	SWAP2
+	PUSH 0x20
+	ADD
+	PUSH 0x40
+	CALLDATACOPY
+	SWAP1
+	JUMP
+
+;; returns the hash of the lower cased symbol argument to the address on the
+;; stack, valid symbols only hold letters and digits so setting the 0x20 bit of
+;; every byte lower cases them
+symbol_hash:
+	PUSH @symbol_copied
+	JUMP @copy_symbol
+symbol_copied:
+	PUSH 0x00
+lower_case:
+	DUP2
+	DUP2
+	LT
+	ISZERO
+	JUMPI @lower_cased
+	PUSH 0x2020202020202020202020202020202020202020202020202020202020202020
+	DUP2
+	PUSH 0x40
+	ADD
+	DUP1
+	MLOAD
+	DUP3
+	OR
+	SWAP1
+	MSTORE
+	POP
+	PUSH 0x20
+	ADD
+	JUMP @lower_case
+lower_cased:
+	POP
+	PUSH 0x40
+	KECCAK256
+	SWAP1
+	JUMP
+`
+
+var (
+	// AssetRegistryABI is the abi of the asset registry
+	AssetRegistryABI abi.ABI
+
+	// AssetRegistryAddress is the address the module deploys the asset registry at
+	AssetRegistryAddress = common.BytesToAddress(address.Module(ModuleName, []byte("registry")))
+
+	// AssetRegistryCode is the code deployed at the asset registry, assembled
+	// from AssetRegistryAsm
+	AssetRegistryCode = common.FromHex(
+		"60043610630000004b5734630000004b5760003560e01c8063ae1e8af9146300000050578063c1733f68146300000097578063864577021463000000e45780632c5054d414630000010c575b600080fd5b60443610630000004b576024358060a01c630000004b576300000073630000018a565b60005260016020526040600020602052600052604060002054421060005260206000f35b60243610630000004b5763000000ad630000018a565b6000526000602052604060002080546000528060010154602052806002015460405280600301546060526004015460805260a06000f35b7f8a6fa22cbcd96428dae964201b351427af5a5a55c3239abdbe74b8771906cd97630000012e565b7fdb5891cbd3c619fa9d603abd6f58cd405836acbc533bc170fe93bfb2474594e05b60443610630000004b576024358060a01c630000004b576300000151630000016d565b602060005280602052601f01601f1916604001903383836000a3005b600435600401803580607f10630000004b57809160200160403790565b6300000196630000016d565b60005b8181101563000001d9577f202020202020202020202020202020202020202020202020202020202020202081604001805182179052506020016300000199565b506040209056",
+	)
+
+	// AssetRegistryCodeHash is the code hash of the asset registry
+	AssetRegistryCodeHash = crypto.Keccak256Hash(AssetRegistryCode)
+
+	// The registry keeps the tokens in a mapping from the hash of their symbol
+	// at slot 0, and the expiry of the authorizations in a mapping from the
+	// hash of the symbol then the address at slot 1
+	assetRegistryTokensSlot         = common.BigToHash(big.NewInt(0))
+	assetRegistryAuthorizationsSlot = common.BigToHash(big.NewInt(1))
+)
+
+// The fields of a token in the asset registry, from the slot of the token on
+const (
+	AssetRegistryManagerField = iota
+	AssetRegistryERC20Field
+	AssetRegistryDecimalsField
+	AssetRegistryPausedField
+	AssetRegistryRestrictionModeField
+)
+
+func init() {
+	var err error
+	AssetRegistryABI, err = abi.JSON(strings.NewReader(assetRegistryABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// assetRegistrySymbolHash returns the key of a symbol in the asset registry mappings
+func assetRegistrySymbolHash(symbol string) []byte {
+	return crypto.Keccak256([]byte(strings.ToLower(symbol)))
+}
+
+// AssetRegistryTokenSlot returns the storage slot of a field of a token in
+// the asset registry
+func AssetRegistryTokenSlot(symbol string, field int64) common.Hash {
+	base := crypto.Keccak256Hash(assetRegistrySymbolHash(symbol), assetRegistryTokensSlot.Bytes()).Big()
+	return common.BigToHash(new(big.Int).Add(base, big.NewInt(field)))
+}
+
+// AssetRegistryAuthorizationSlot returns the storage slot of the expiry of
+// the authorization of an account for a token in the asset registry
+func AssetRegistryAuthorizationSlot(symbol string, account common.Address) common.Hash {
+	tokenSlot := crypto.Keccak256Hash(assetRegistrySymbolHash(symbol), assetRegistryAuthorizationsSlot.Bytes())
+	return crypto.Keccak256Hash(common.LeftPadBytes(account.Bytes(), 32), tokenSlot.Bytes())
+}
@@ -13,6 +13,40 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAuthorizeAddress{}, "asset/AuthorizeAddress", nil)
 	cdc.RegisterConcrete(&MsgUnAuthorizeAddress{}, "asset/UnAuthorizeAddress", nil)
 	cdc.RegisterConcrete(&MsgTransferToken{}, "asset/TransferToken", nil)
+	cdc.RegisterConcrete(&MsgMintToken{}, "asset/MintToken", nil)
+	cdc.RegisterConcrete(&MsgBurnToken{}, "asset/BurnToken", nil)
+	cdc.RegisterConcrete(&MsgRequestRedemption{}, "asset/RequestRedemption", nil)
+	cdc.RegisterConcrete(&MsgRedeemToken{}, "asset/RedeemToken", nil)
+	cdc.RegisterConcrete(&MsgCancelRedemption{}, "asset/CancelRedemption", nil)
+	cdc.RegisterConcrete(&MsgProposeManager{}, "asset/ProposeManager", nil)
+	cdc.RegisterConcrete(&MsgAcceptManager{}, "asset/AcceptManager", nil)
+	cdc.RegisterConcrete(&MsgCancelManagerProposal{}, "asset/CancelManagerProposal", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "asset/GrantRole", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "asset/RevokeRole", nil)
+	cdc.RegisterConcrete(&MsgFreezeAddress{}, "asset/FreezeAddress", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAddress{}, "asset/UnfreezeAddress", nil)
+	cdc.RegisterConcrete(&MsgPauseToken{}, "asset/PauseToken", nil)
+	cdc.RegisterConcrete(&MsgUnpauseToken{}, "asset/UnpauseToken", nil)
+	cdc.RegisterConcrete(&MsgForceTransfer{}, "asset/ForceTransfer", nil)
+	cdc.RegisterConcrete(&MsgBatchAuthorize{}, "asset/BatchAuthorize", nil)
+	cdc.RegisterConcrete(&MsgBatchUnAuthorize{}, "asset/BatchUnAuthorize", nil)
+	cdc.RegisterConcrete(&MsgUpdateHolderLimits{}, "asset/UpdateHolderLimits", nil)
+	cdc.RegisterConcrete(&MsgSetBlackoutSchedule{}, "asset/SetBlackoutSchedule", nil)
+	cdc.RegisterConcrete(&MsgSetComplianceRules{}, "asset/SetComplianceRules", nil)
+	cdc.RegisterConcrete(&MsgSetJurisdiction{}, "asset/SetJurisdiction", nil)
+	cdc.RegisterConcrete(&MsgSetRestrictionMode{}, "asset/SetRestrictionMode", nil)
+	cdc.RegisterConcrete(&MsgDenyAddress{}, "asset/DenyAddress", nil)
+	cdc.RegisterConcrete(&MsgUndenyAddress{}, "asset/UndenyAddress", nil)
+	cdc.RegisterConcrete(&MsgSetIBCEnabled{}, "asset/SetIBCEnabled", nil)
+	cdc.RegisterConcrete(&MsgReplicateToken{}, "asset/ReplicateToken", nil)
+	cdc.RegisterConcrete(&MsgSendToken{}, "asset/SendToken", nil)
+	cdc.RegisterConcrete(&MsgDistribute{}, "asset/Distribute", nil)
+	cdc.RegisterConcrete(&MsgClaimDistribution{}, "asset/ClaimDistribution", nil)
+	cdc.RegisterConcrete(&MsgCreateSnapshot{}, "asset/CreateSnapshot", nil)
+	cdc.RegisterConcrete(&MsgSplitToken{}, "asset/SplitToken", nil)
+	cdc.RegisterConcrete(&MsgCreateSettlement{}, "asset/CreateSettlement", nil)
+	cdc.RegisterConcrete(&MsgAffirmSettlement{}, "asset/AffirmSettlement", nil)
+	cdc.RegisterConcrete(&MsgCancelSettlement{}, "asset/CancelSettlement", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -32,6 +66,108 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgTransferToken{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgMintToken{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBurnToken{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestRedemption{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRedeemToken{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelRedemption{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgProposeManager{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptManager{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelManagerProposal{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantRole{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeRole{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFreezeAddress{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnfreezeAddress{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPauseToken{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnpauseToken{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgForceTransfer{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchAuthorize{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchUnAuthorize{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateHolderLimits{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetBlackoutSchedule{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetComplianceRules{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetJurisdiction{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetRestrictionMode{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDenyAddress{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUndenyAddress{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetIBCEnabled{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReplicateToken{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSendToken{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDistribute{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaimDistribution{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateSnapshot{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSplitToken{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateSettlement{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAffirmSettlement{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelSettlement{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxComplianceRules bounds the rules checked on every transfer of a token
+const MaxComplianceRules = 16
+
+// Built-in compliance rule ids
+const (
+	RuleIDBlackout      = "blackout"
+	RuleIDFreeze        = "freeze"
+	RuleIDAuthorization = "authorization"
+	RuleIDMaxBalance    = "max_balance"
+	RuleIDJurisdiction  = "jurisdiction"
+)
+
+// MandatoryComplianceRules are checked on every token before the rules it
+// configures, like pausing they cannot be turned off. Each of them only
+// applies once the matching token setting is set.
+var MandatoryComplianceRules = []ComplianceRuleConfig{
+	{Id: RuleIDBlackout},
+	{Id: RuleIDFreeze},
+	{Id: RuleIDAuthorization},
+	{Id: RuleIDMaxBalance},
+}
+
+// ComplianceTransfer is a send of a token checked by the compliance rules
+type ComplianceTransfer struct {
+	From sdk.AccAddress
+	To   sdk.AccAddress
+	Coin sdk.Coin
+}
+
+// ComplianceState is the state of the module compliance rules can read
+type ComplianceState interface {
+	// AllowAddr returns whether the address is a module account exempt from restrictions
+	AllowAddr(address sdk.AccAddress) bool
+	// IsTransferEscrow returns whether the address is the ICS-20 escrow account
+	// of a channel, which is exempt from the checks of the side it is on
+	IsTransferEscrow(ctx sdk.Context, address sdk.AccAddress) bool
+	IsAddressAuthorizedToSend(ctx sdk.Context, symbol string, address sdk.AccAddress) bool
+	IsAddressFrozen(ctx sdk.Context, symbol string, address sdk.AccAddress) bool
+	IsAddressDenied(ctx sdk.Context, symbol string, address sdk.AccAddress) bool
+	GetJurisdiction(ctx sdk.Context, symbol string, address sdk.AccAddress) string
+	GetBalance(ctx sdk.Context, address sdk.AccAddress, denom string) sdk.Coin
+}
+
+// ComplianceRule is a regulatory check a token can enable. The keeper checks
+// the enabled rules of a token in order on every send, and rejects the send
+// with the error of the first rule that fails.
+type ComplianceRule interface {
+	// ID is the identifier tokens enable the rule with
+	ID() string
+	// ValidateParams checks the params a token enables the rule with
+	ValidateParams(params string) error
+	// Check returns an error if the transfer breaks the rule
+	Check(ctx sdk.Context, state ComplianceState, token Token, params string, transfer ComplianceTransfer) error
+}
+
+// BuiltinComplianceRules returns the compliance rules known to every keeper
+func BuiltinComplianceRules() []ComplianceRule {
+	return []ComplianceRule{
+		BlackoutRule{},
+		FreezeRule{},
+		AuthorizationRule{},
+		MaxBalanceRule{},
+		JurisdictionRule{},
+	}
+}
+
+// IsMandatoryComplianceRule checks if the rule is one of the MandatoryComplianceRules
+func IsMandatoryComplianceRule(id string) bool {
+	for _, rule := range MandatoryComplianceRules {
+		if rule.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// GetEnabledComplianceRules returns the rules checked on transfers of the
+// token, the mandatory rules followed by the rules the token configures.
+// Mandatory rules configured before they were mandatory are only checked once.
+func (t Token) GetEnabledComplianceRules() []ComplianceRuleConfig {
+	rules := make([]ComplianceRuleConfig, 0, len(MandatoryComplianceRules)+len(t.ComplianceRules))
+	rules = append(rules, MandatoryComplianceRules...)
+	for _, rule := range t.ComplianceRules {
+		if !IsMandatoryComplianceRule(rule.Id) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// validateNoParams is the ValidateParams of rules configured on the token itself
+func validateNoParams(params string) error {
+	if params != "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rule takes no params")
+	}
+	return nil
+}
+
+// BlackoutRule blocks transfers during the blackout windows of the token
+type BlackoutRule struct{}
+
+func (BlackoutRule) ID() string { return RuleIDBlackout }
+
+func (BlackoutRule) ValidateParams(params string) error { return validateNoParams(params) }
+
+func (BlackoutRule) Check(ctx sdk.Context, state ComplianceState, token Token, _ string, transfer ComplianceTransfer) error {
+	if state.AllowAddr(transfer.From) || !token.InBlackout(ctx.BlockTime()) {
+		return nil
+	}
+	return sdkerrors.Wrapf(ErrTransferBlackout, "%s transfers are blocked until the blackout window ends", transfer.Coin.Denom)
+}
+
+// FreezeRule blocks frozen addresses from sending the token, they can still receive it
+type FreezeRule struct{}
+
+func (FreezeRule) ID() string { return RuleIDFreeze }
+
+func (FreezeRule) ValidateParams(params string) error { return validateNoParams(params) }
+
+func (FreezeRule) Check(ctx sdk.Context, state ComplianceState, token Token, _ string, transfer ComplianceTransfer) error {
+	if state.AllowAddr(transfer.From) || !state.IsAddressFrozen(ctx, token.Symbol, transfer.From) {
+		return nil
+	}
+	return sdkerrors.Wrapf(ErrAddressFrozen, "%s is frozen for %s", transfer.From, transfer.Coin.Denom)
+}
+
+// AuthorizationRule applies the restriction mode of the token, both sides must
+// be authorized in allowlist mode, and neither can be denied in denylist mode.
+// ICS-20 escrow accounts do not need to be authorized.
+type AuthorizationRule struct{}
+
+func (AuthorizationRule) ID() string { return RuleIDAuthorization }
+
+func (AuthorizationRule) ValidateParams(params string) error { return validateNoParams(params) }
+
+func (AuthorizationRule) Check(ctx sdk.Context, state ComplianceState, token Token, _ string, transfer ComplianceTransfer) error {
+	if state.AllowAddr(transfer.From) {
+		return nil
+	}
+	switch token.EffectiveRestrictionMode() {
+	case RestrictionModeAllowlist:
+		for _, address := range []sdk.AccAddress{transfer.From, transfer.To} {
+			if !state.IsAddressAuthorizedToSend(ctx, token.Symbol, address) && !state.IsTransferEscrow(ctx, address) {
+				return sdkerrors.Wrapf(ErrNotAuthorized, "%s is not authorized to transact with %s", address, transfer.Coin.Denom)
+			}
+		}
+	case RestrictionModeDenylist:
+		for _, address := range []sdk.AccAddress{transfer.From, transfer.To} {
+			if state.IsAddressDenied(ctx, token.Symbol, address) {
+				return sdkerrors.Wrapf(ErrAddressDenied, "%s is denied for %s", address, transfer.Coin.Denom)
+			}
+		}
+	}
+	return nil
+}
+
+// MaxBalanceRule caps the balance of the receiver at the maxBalancePerHolder of
+// the token. It also applies to sends out of module accounts, such as mints,
+// while the manager, module accounts and ICS-20 escrow accounts are exempt as
+// receivers.
+type MaxBalanceRule struct{}
+
+func (MaxBalanceRule) ID() string { return RuleIDMaxBalance }
+
+func (MaxBalanceRule) ValidateParams(params string) error { return validateNoParams(params) }
+
+func (MaxBalanceRule) Check(ctx sdk.Context, state ComplianceState, token Token, _ string, transfer ComplianceTransfer) error {
+	if token.MaxBalancePerHolder == "" || state.AllowAddr(transfer.To) || transfer.To.String() == token.Manager {
+		return nil
+	}
+	maxBalance, _ := math.NewIntFromString(token.MaxBalancePerHolder)
+	balance := state.GetBalance(ctx, transfer.To, transfer.Coin.Denom).Amount.Add(transfer.Coin.Amount)
+	if balance.GT(token.BaseUnits(maxBalance)) && !state.IsTransferEscrow(ctx, transfer.To) {
+		return sdkerrors.Wrapf(ErrMaxBalanceExceeded, "%s would hold more than %s %s", transfer.To, token.MaxBalancePerHolder, token.Symbol)
+	}
+	return nil
+}
+
+// JurisdictionRule requires both sides to be in one of the jurisdictions listed
+// in its params, separated by commas such as "US,CA". ICS-20 escrow accounts
+// have no jurisdiction.
+type JurisdictionRule struct{}
+
+func (JurisdictionRule) ID() string { return RuleIDJurisdiction }
+
+func (JurisdictionRule) ValidateParams(params string) error {
+	for _, jurisdiction := range strings.Split(params, ",") {
+		if strings.TrimSpace(jurisdiction) == "" {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid jurisdiction list %q", params)
+		}
+	}
+	return nil
+}
+
+func (JurisdictionRule) Check(ctx sdk.Context, state ComplianceState, token Token, params string, transfer ComplianceTransfer) error {
+	if state.AllowAddr(transfer.From) {
+		return nil
+	}
+	allowed := make(map[string]bool)
+	for _, jurisdiction := range strings.Split(params, ",") {
+		allowed[strings.TrimSpace(jurisdiction)] = true
+	}
+	for _, address := range []sdk.AccAddress{transfer.From, transfer.To} {
+		if !allowed[state.GetJurisdiction(ctx, token.Symbol, address)] && !state.IsTransferEscrow(ctx, address) {
+			return sdkerrors.Wrapf(ErrJurisdictionNotAllowed, "%s is not in an allowed jurisdiction for %s", address, transfer.Coin.Denom)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+package types
+
+func NewDeniedAddress(symbol string, address string) DeniedAddress {
+	return DeniedAddress{
+		Symbol:  symbol,
+		Address: address,
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxDistributionPayoutsPerBlock bounds the claims paid out by the EndBlocker in a block
+const MaxDistributionPayoutsPerBlock = 100
+
+// MaxDistributionAllocationsPerBlock bounds the store entries the EndBlocker
+// visits in a block to allocate distributions
+const MaxDistributionAllocationsPerBlock = 100
+
+// validateDistributionPolicy checks that a policy is one of the known distribution policies.
+func validateDistributionPolicy(policy DistributionPolicy) error {
+	if _, ok := DistributionPolicy_name[int32(policy)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid distribution policy %s", policy)
+	}
+	return nil
+}
@@ -0,0 +1,1302 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realionetwork/asset/v1/distribution.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DistributionPolicy decides what happens to the share of a holder that is
+// not eligible to receive it, because it is frozen or not allowed by the
+// restriction mode of the token.
+type DistributionPolicy int32
+
+const (
+	// DISTRIBUTION_POLICY_HOLD keeps the share until the holder is eligible
+	// again and claims it.
+	DistributionPolicyHold DistributionPolicy = 0
+	// DISTRIBUTION_POLICY_FORFEIT returns the share to the distributor.
+	DistributionPolicyForfeit DistributionPolicy = 1
+)
+
+var DistributionPolicy_name = map[int32]string{
+	0: "DISTRIBUTION_POLICY_HOLD",
+	1: "DISTRIBUTION_POLICY_FORFEIT",
+}
+
+var DistributionPolicy_value = map[string]int32{
+	"DISTRIBUTION_POLICY_HOLD":    0,
+	"DISTRIBUTION_POLICY_FORFEIT": 1,
+}
+
+func (x DistributionPolicy) String() string {
+	return proto.EnumName(DistributionPolicy_name, int32(x))
+}
+
+func (DistributionPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f54f66a46b7bff03, []int{0}
+}
+
+// DistributionStage tracks the allocation of a distribution to the holders of
+// the token, which the EndBlocker carries out in batches.
+type DistributionStage int32
+
+const (
+	// DISTRIBUTION_STAGE_ALLOCATED means every share is allocated, the
+	// distribution waits for its pending claims.
+	DistributionStageAllocated DistributionStage = 0
+	// DISTRIBUTION_STAGE_HOLDERS allocates shares to the current holders of the
+	// token.
+	DistributionStageHolders DistributionStage = 1
+	// DISTRIBUTION_STAGE_MOVED allocates shares to the addresses whose balance
+	// moved since the snapshot, which may no longer hold the token.
+	DistributionStageMoved DistributionStage = 2
+	// DISTRIBUTION_STAGE_PRUNING clears the markers of the shares settled while
+	// the distribution was allocated.
+	DistributionStagePruning DistributionStage = 3
+)
+
+var DistributionStage_name = map[int32]string{
+	0: "DISTRIBUTION_STAGE_ALLOCATED",
+	1: "DISTRIBUTION_STAGE_HOLDERS",
+	2: "DISTRIBUTION_STAGE_MOVED",
+	3: "DISTRIBUTION_STAGE_PRUNING",
+}
+
+var DistributionStage_value = map[string]int32{
+	"DISTRIBUTION_STAGE_ALLOCATED": 0,
+	"DISTRIBUTION_STAGE_HOLDERS":   1,
+	"DISTRIBUTION_STAGE_MOVED":     2,
+	"DISTRIBUTION_STAGE_PRUNING":   3,
+}
+
+func (x DistributionStage) String() string {
+	return proto.EnumName(DistributionStage_name, int32(x))
+}
+
+func (DistributionStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f54f66a46b7bff03, []int{1}
+}
+
+// Distribution is an amount escrowed by an issuer of a token and paid pro rata
+// to the holders of the token at the height it was created
+type Distribution struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Symbol      string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Distributor string `protobuf:"bytes,3,opt,name=distributor,proto3" json:"distributor,omitempty"`
+	// denom and amount of the whole distribution
+	Denom  string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// height of the balance snapshot
+	Height int64              `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Policy DistributionPolicy `protobuf:"varint,7,opt,name=policy,proto3,enum=realionetwork.asset.v1.DistributionPolicy" json:"policy,omitempty"`
+	// number of claims allocated and not paid out yet
+	PendingClaims uint64 `protobuf:"varint,8,opt,name=pendingClaims,proto3" json:"pendingClaims,omitempty"`
+	// id of the snapshot of the token taken at height
+	SnapshotId uint64 `protobuf:"varint,9,opt,name=snapshotId,proto3" json:"snapshotId,omitempty"`
+	// sum of the snapshot balances sharing the distribution, set at the end of
+	// the block the distribution is created in
+	Supply string `protobuf:"bytes,10,opt,name=supply,proto3" json:"supply,omitempty"`
+	// sum of the shares allocated so far
+	Allocated string            `protobuf:"bytes,11,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Stage     DistributionStage `protobuf:"varint,12,opt,name=stage,proto3,enum=realionetwork.asset.v1.DistributionStage" json:"stage,omitempty"`
+	// store key the allocation resumes from in the current stage
+	Cursor []byte `protobuf:"bytes,13,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *Distribution) Reset()         { *m = Distribution{} }
+func (m *Distribution) String() string { return proto.CompactTextString(m) }
+func (*Distribution) ProtoMessage()    {}
+func (*Distribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f54f66a46b7bff03, []int{0}
+}
+func (m *Distribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Distribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Distribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Distribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Distribution.Merge(m, src)
+}
+func (m *Distribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *Distribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Distribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Distribution proto.InternalMessageInfo
+
+func (m *Distribution) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Distribution) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *Distribution) GetDistributor() string {
+	if m != nil {
+		return m.Distributor
+	}
+	return ""
+}
+
+func (m *Distribution) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Distribution) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *Distribution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Distribution) GetPolicy() DistributionPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return DistributionPolicyHold
+}
+
+func (m *Distribution) GetPendingClaims() uint64 {
+	if m != nil {
+		return m.PendingClaims
+	}
+	return 0
+}
+
+func (m *Distribution) GetSnapshotId() uint64 {
+	if m != nil {
+		return m.SnapshotId
+	}
+	return 0
+}
+
+func (m *Distribution) GetSupply() string {
+	if m != nil {
+		return m.Supply
+	}
+	return ""
+}
+
+func (m *Distribution) GetAllocated() string {
+	if m != nil {
+		return m.Allocated
+	}
+	return ""
+}
+
+func (m *Distribution) GetStage() DistributionStage {
+	if m != nil {
+		return m.Stage
+	}
+	return DistributionStageAllocated
+}
+
+func (m *Distribution) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+// DistributionClaim is the share of a distribution owed to a holder
+type DistributionClaim struct {
+	DistributionId uint64 `protobuf:"varint,1,opt,name=distributionId,proto3" json:"distributionId,omitempty"`
+	Holder         string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// balance of the holder in the snapshot, in base units of the token
+	Balance string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	// share of the holder, in the denom of the distribution
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// a held claim is no longer paid out by the EndBlocker and waits for the
+	// holder to claim it
+	Held bool `protobuf:"varint,5,opt,name=held,proto3" json:"held,omitempty"`
+}
+
+func (m *DistributionClaim) Reset()         { *m = DistributionClaim{} }
+func (m *DistributionClaim) String() string { return proto.CompactTextString(m) }
+func (*DistributionClaim) ProtoMessage()    {}
+func (*DistributionClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f54f66a46b7bff03, []int{1}
+}
+func (m *DistributionClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionClaim.Merge(m, src)
+}
+func (m *DistributionClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionClaim proto.InternalMessageInfo
+
+func (m *DistributionClaim) GetDistributionId() uint64 {
+	if m != nil {
+		return m.DistributionId
+	}
+	return 0
+}
+
+func (m *DistributionClaim) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *DistributionClaim) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *DistributionClaim) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *DistributionClaim) GetHeld() bool {
+	if m != nil {
+		return m.Held
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("realionetwork.asset.v1.DistributionPolicy", DistributionPolicy_name, DistributionPolicy_value)
+	proto.RegisterEnum("realionetwork.asset.v1.DistributionStage", DistributionStage_name, DistributionStage_value)
+	proto.RegisterType((*Distribution)(nil), "realionetwork.asset.v1.Distribution")
+	proto.RegisterType((*DistributionClaim)(nil), "realionetwork.asset.v1.DistributionClaim")
+}
+
+func init() {
+	proto.RegisterFile("realionetwork/asset/v1/distribution.proto", fileDescriptor_f54f66a46b7bff03)
+}
+
+var fileDescriptor_f54f66a46b7bff03 = []byte{
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x24, 0x69, 0xda, 0x4e, 0x7f, 0x94, 0x8e, 0xaa, 0x6a, 0x3e, 0x7f, 0xc5, 0xb2, 0x2a,
+	0x84, 0xd2, 0x4a, 0x24, 0x6a, 0xd9, 0xb0, 0x40, 0x40, 0xda, 0xa4, 0xad, 0xa5, 0xb4, 0x8e, 0x9c,
+	0x14, 0x09, 0x36, 0x95, 0x63, 0x0f, 0xf6, 0x08, 0xc7, 0x63, 0xd9, 0xe3, 0x42, 0xde, 0x00, 0x65,
+	0x05, 0x12, 0x1b, 0x16, 0x59, 0xf1, 0x10, 0xbc, 0x02, 0xcb, 0x2e, 0x59, 0xa2, 0xf6, 0x45, 0x90,
+	0xc7, 0x4e, 0x71, 0x70, 0x2a, 0xb1, 0x9b, 0x73, 0xe7, 0x9e, 0x7b, 0xe7, 0x9e, 0x33, 0xba, 0x70,
+	0x37, 0x20, 0x86, 0x4b, 0x99, 0x47, 0xf8, 0x7b, 0x16, 0xbc, 0x6b, 0x18, 0x61, 0x48, 0x78, 0xe3,
+	0x6a, 0xbf, 0x61, 0xd1, 0x90, 0x07, 0x74, 0x10, 0x71, 0xca, 0xbc, 0xba, 0x1f, 0x30, 0xce, 0xd0,
+	0xd6, 0x4c, 0x6a, 0x5d, 0xa4, 0xd6, 0xaf, 0xf6, 0xa5, 0x4d, 0x9b, 0xd9, 0x4c, 0xa4, 0x34, 0xe2,
+	0x53, 0x92, 0xbd, 0xf3, 0xbd, 0x04, 0x57, 0x5b, 0x99, 0x22, 0x68, 0x1d, 0x16, 0xa9, 0x85, 0x81,
+	0x02, 0x6a, 0x65, 0xbd, 0x48, 0x2d, 0xb4, 0x05, 0x2b, 0xe1, 0x68, 0x38, 0x60, 0x2e, 0x2e, 0x2a,
+	0xa0, 0xb6, 0xac, 0xa7, 0x08, 0x29, 0x70, 0xe5, 0xae, 0x39, 0x0b, 0x70, 0x49, 0x5c, 0x66, 0x43,
+	0x68, 0x13, 0x2e, 0x58, 0xc4, 0x63, 0x43, 0x5c, 0x16, 0x77, 0x09, 0x88, 0xeb, 0x19, 0x43, 0x16,
+	0x79, 0x1c, 0x2f, 0x24, 0xf5, 0x12, 0x14, 0xc7, 0x1d, 0x42, 0x6d, 0x87, 0xe3, 0x8a, 0x02, 0x6a,
+	0x25, 0x3d, 0x45, 0xe8, 0x10, 0x56, 0x7c, 0xe6, 0x52, 0x73, 0x84, 0x17, 0x15, 0x50, 0x5b, 0x3f,
+	0xd8, 0xab, 0xcf, 0x9f, 0xaf, 0x9e, 0x9d, 0xa2, 0x2b, 0x18, 0x7a, 0xca, 0x44, 0x0f, 0xe1, 0x9a,
+	0x4f, 0x3c, 0x8b, 0x7a, 0xf6, 0x91, 0x6b, 0xd0, 0x61, 0x88, 0x97, 0xc4, 0x78, 0xb3, 0x41, 0x24,
+	0x43, 0x18, 0x7a, 0x86, 0x1f, 0x3a, 0x8c, 0xab, 0x16, 0x5e, 0x16, 0x29, 0x99, 0x88, 0x50, 0x22,
+	0xf2, 0x7d, 0x77, 0x84, 0x61, 0xaa, 0x84, 0x40, 0x68, 0x1b, 0x2e, 0x1b, 0xae, 0xcb, 0x4c, 0x83,
+	0x13, 0x0b, 0xaf, 0x88, 0xab, 0x3f, 0x01, 0xf4, 0x02, 0x2e, 0x84, 0xdc, 0xb0, 0x09, 0x5e, 0x15,
+	0xcf, 0xdf, 0xfd, 0x97, 0xe7, 0xf7, 0x62, 0x82, 0x9e, 0xf0, 0xe2, 0xb6, 0x66, 0x14, 0x84, 0x2c,
+	0xc0, 0x6b, 0x0a, 0xa8, 0xad, 0xea, 0x29, 0xda, 0xf9, 0x0a, 0xe0, 0x46, 0x96, 0x24, 0xa6, 0x40,
+	0x8f, 0xe0, 0x7a, 0xf6, 0x4f, 0xa8, 0x53, 0x2b, 0xff, 0x8a, 0x0a, 0xb9, 0x99, 0x6b, 0x91, 0x60,
+	0x6a, 0x6b, 0x82, 0x10, 0x86, 0x8b, 0x03, 0xc3, 0x35, 0x3c, 0x93, 0xa4, 0x96, 0x4e, 0x61, 0xc6,
+	0xb8, 0xf2, 0x8c, 0x71, 0x08, 0x96, 0x1d, 0xe2, 0x5a, 0xc2, 0xce, 0x25, 0x5d, 0x9c, 0xf7, 0xbe,
+	0x00, 0x88, 0xf2, 0x7e, 0xa0, 0xa7, 0x10, 0xb7, 0xd4, 0x5e, 0x5f, 0x57, 0x0f, 0x2f, 0xfa, 0xaa,
+	0x76, 0x7e, 0xd9, 0xd5, 0x3a, 0xea, 0xd1, 0xeb, 0xcb, 0x53, 0xad, 0xd3, 0xaa, 0x16, 0x24, 0x69,
+	0x3c, 0x51, 0xb6, 0xf2, 0xac, 0x53, 0xe6, 0x5a, 0xe8, 0x39, 0xfc, 0x7f, 0x1e, 0xf3, 0x58, 0xd3,
+	0x8f, 0xdb, 0x6a, 0xbf, 0x0a, 0xa4, 0x07, 0xe3, 0x89, 0xf2, 0x5f, 0x9e, 0x7c, 0xcc, 0x82, 0xb7,
+	0x84, 0x72, 0xa9, 0xfc, 0xf1, 0x9b, 0x5c, 0xd8, 0xfb, 0x5c, 0x84, 0x1b, 0x39, 0x9d, 0xd1, 0x4b,
+	0xb8, 0x3d, 0x53, 0xbb, 0xd7, 0x6f, 0x9e, 0xb4, 0x2f, 0x9b, 0x9d, 0x8e, 0x76, 0xd4, 0xec, 0xb7,
+	0xe3, 0x97, 0xc9, 0xe3, 0x89, 0x22, 0xe5, 0x88, 0xcd, 0x3b, 0x8f, 0x9f, 0x41, 0x69, 0x4e, 0x85,
+	0x78, 0xac, 0xb6, 0xde, 0xab, 0x02, 0x69, 0x7b, 0x3c, 0x51, 0x70, 0x8e, 0x7f, 0x2a, 0x14, 0x0f,
+	0x73, 0xaa, 0x24, 0xec, 0x33, 0xed, 0x55, 0xbb, 0x55, 0x2d, 0xe6, 0x55, 0x11, 0xdc, 0x33, 0x76,
+	0x75, 0x6f, 0xdf, 0xae, 0x7e, 0x71, 0xae, 0x9e, 0x9f, 0x54, 0x4b, 0xf7, 0xf4, 0xed, 0x06, 0x91,
+	0x47, 0x3d, 0x3b, 0xd1, 0xe4, 0xb0, 0xf3, 0xe3, 0x46, 0x06, 0xd7, 0x37, 0x32, 0xf8, 0x75, 0x23,
+	0x83, 0x4f, 0xb7, 0x72, 0xe1, 0xfa, 0x56, 0x2e, 0xfc, 0xbc, 0x95, 0x0b, 0x6f, 0x0e, 0x6c, 0xca,
+	0x9d, 0x68, 0x50, 0x37, 0xd9, 0xb0, 0x91, 0x7c, 0x5a, 0x4e, 0x4c, 0x27, 0x3d, 0x3e, 0x9e, 0xae,
+	0xa2, 0x0f, 0xe9, 0x32, 0xe2, 0x23, 0x9f, 0x84, 0x83, 0x8a, 0xd8, 0x2a, 0x4f, 0x7e, 0x0f, 0x00,
+	0xee, 0x1f, 0x31, 0x16, 0xb0, 0x04, 0x00, 0x00,
+}
+
+func (m *Distribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Stage != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Allocated) > 0 {
+		i -= len(m.Allocated)
+		copy(dAtA[i:], m.Allocated)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Allocated)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Supply) > 0 {
+		i -= len(m.Supply)
+		copy(dAtA[i:], m.Supply)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Supply)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.SnapshotId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.SnapshotId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PendingClaims != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PendingClaims))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Policy != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Distributor) > 0 {
+		i -= len(m.Distributor)
+		copy(dAtA[i:], m.Distributor)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Distributor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Held {
+		i--
+		if m.Held {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DistributionId != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.DistributionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Distributor)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovDistribution(uint64(m.Policy))
+	}
+	if m.PendingClaims != 0 {
+		n += 1 + sovDistribution(uint64(m.PendingClaims))
+	}
+	if m.SnapshotId != 0 {
+		n += 1 + sovDistribution(uint64(m.SnapshotId))
+	}
+	l = len(m.Supply)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Allocated)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Stage != 0 {
+		n += 1 + sovDistribution(uint64(m.Stage))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *DistributionClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionId != 0 {
+		n += 1 + sovDistribution(uint64(m.DistributionId))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.Held {
+		n += 2
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Distribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= DistributionPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingClaims", wireType)
+			}
+			m.PendingClaims = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingClaims |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotId", wireType)
+			}
+			m.SnapshotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocated = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= DistributionStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionId", wireType)
+			}
+			m.DistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Held = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDistribution(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDistribution
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDistribution
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDistribution
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDistribution        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDistribution          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDistribution = fmt.Errorf("proto: unexpected end of group")
+)
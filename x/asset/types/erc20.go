@@ -0,0 +1,326 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// erc20ABIJSON is the subset of the ERC-20 interface served by the facades
+const erc20ABIJSON = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+// ERC20FacadeAsm is the source of ERC20FacadeCode, in the assembly of the
+// go-ethereum asm package. It serves the ERC-20 subset of ERC20ABI from the
+// facade storage, which the keeper keeps equal to the bank. A transfer moves
+// the facade balances and emits the Transfer log, which the EVM hooks settle
+// in the bank after the transaction. Calls with a value revert.
+const ERC20FacadeAsm = `
+	PUSH 0x04
+	CALLDATASIZE
+	LT
+	JUMPI @fail
+	CALLVALUE
+	JUMPI @fail
+	PUSH 0x00
+	CALLDATALOAD
+	PUSH 0xe0
+	SHR
+	DUP1
+	PUSH 0x06fdde03
+	EQ
+	JUMPI @name
+	DUP1
+	PUSH 0x95d89b41
+	EQ
+	JUMPI @symbol
+	DUP1
+	PUSH 0x313ce567
+	EQ
+	JUMPI @decimals
+	DUP1
+	PUSH 0x18160ddd
+	EQ
+	JUMPI @total_supply
+	DUP1
+	PUSH 0x70a08231
+	EQ
+	JUMPI @balance_of
+	DUP1
+	PUSH 0xa9059cbb
+	EQ
+	JUMPI @transfer
+fail:
+	PUSH 0x00
+	DUP1
+	REVERT
+
+name:
+	PUSH 0x03
+	JUMP @return_string
+symbol:
+	PUSH 0x04
+	JUMP @return_string
+decimals:
+	PUSH 0x05
+	SLOAD
+	JUMP @return_word
+total_supply:
+	PUSH 0x02
+	SLOAD
+	JUMP @return_word
+
+;; balanceOf(address account)
+balance_of:
+	PUSH 0x24
+	CALLDATASIZE
+	LT
+	JUMPI @fail
+	PUSH 0x04
+	CALLDATALOAD
+	DUP1
+	PUSH 0xa0
+	SHR
+	JUMPI @fail
+	PUSH 0x00
+	MSTORE
+	PUSH 0x00
+	PUSH 0x20
+	MSTORE
+	PUSH 0x40
+	PUSH 0x00
+	KECCAK256
+	SLOAD
+	JUMP @return_word
+
+;; transfer(address to, uint256 amount)
+transfer:
+	PUSH 0x44
+	CALLDATASIZE
+	LT
+	JUMPI @fail
+	PUSH 0x04
+	CALLDATALOAD
+	DUP1
+	PUSH 0xa0
+	SHR
+	JUMPI @fail
+	DUP1
+	ISZERO
+	JUMPI @fail
+	PUSH 0x24
+	CALLDATALOAD
+	;; the balance of the caller
+	CALLER
+	PUSH 0x00
+	MSTORE
+	PUSH 0x00
+	PUSH 0x20
+	MSTORE
+	PUSH 0x40
+	PUSH 0x00
+	KECCAK256
+	DUP1
+	SLOAD
+	DUP3
+	DUP2
+	LT
+	JUMPI @fail
+	DUP3
+	SWAP1
+	SUB
+	SWAP1
+	SSTORE
+	;; the balance of the receiver
+	DUP2
+	PUSH 0x00
+	MSTORE
+	PUSH 0x40
+	PUSH 0x00
+	KECCAK256
+	DUP1
+	SLOAD
+	DUP3
+	ADD
+	SWAP1
+	SSTORE
+	;; Transfer(caller, to, amount)
+	PUSH 0x00
+	MSTORE
+	CALLER
+	PUSH 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+	PUSH 0x20
+	PUSH 0x00
+	LOG3
+	PUSH 0x01
+	JUMP @return_word
+
+;; returns the word on the stack
+return_word:
+	PUSH 0x00
+	MSTORE
+	PUSH 0x20
+	PUSH 0x00
+	RETURN
+
+;; returns the string stored at the slot on the stack, in the solidity layout
+return_string:
+	DUP1
+	SLOAD
+	DUP1
+	PUSH 0x01
+	AND
+	JUMPI @long_string
+	DUP1
+	PUSH 0xff
+	AND
+	PUSH 0x01
+	SHR
+	PUSH 0x20
+	MSTORE
+	PUSH 0xff
+	NOT
+	AND
+	PUSH 0x40
+	MSTORE
+	PUSH 0x20
+	PUSH 0x00
+	MSTORE
+	PUSH 0x60
+	PUSH 0x00
+	RETURN
+long_string:
+	PUSH 0x01
+	SHR
+	DUP1
+	PUSH 0x20
+	MSTORE
+	SWAP1
+	PUSH 0x00
+	MSTORE
+	PUSH 0x20
+	PUSH 0x00
+	KECCAK256
+	PUSH 0x00
+string_copy:
+	DUP3
+	DUP2
+	LT
+	ISZERO
+	JUMPI @string_copied
+	DUP2
+	DUP2
+	PUSH 0x05
+	SHR
+	ADD
+	SLOAD
+	DUP2
+	PUSH 0x40
+	ADD
+	MSTORE
+	PUSH 0x20
+	ADD
+	JUMP @string_copy
+string_copied:
+	PUSH 0x20
+	PUSH 0x00
+	MSTORE
+	PUSH 0x40
+	ADD
+	PUSH 0x00
+	RETURN
+`
+
+var (
+	// ERC20ABI is the abi of the ERC-20 facades of the tokens
+	ERC20ABI abi.ABI
+
+	// ERC20FacadeCode is the code deployed at the ERC-20 facades, assembled
+	// from ERC20FacadeAsm
+	ERC20FacadeCode = common.FromHex(
+		"600436106300000065573463000000655760003560e01c806306fdde0314630000006a57806395d89b41146300000073578063313ce56714630000007c57806318160ddd14630000008657806370a08231146300000090578063a9059cbb1463000000bc575b600080fd5b60036300000147565b60046300000147565b600554630000013e565b600254630000013e565b602436106300000065576004358060a01c6300000065576000526000602052604060002054630000013e565b604436106300000065576004358060a01c6300000065578015630000006557602435336000526000602052604060002080548281106300000065578290039055816000526040600020805482019055600052337fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef60206000a36001630000013e565b60005260206000f35b805480600116630000016f578060ff1660011c60205260ff1916604052602060005260606000f35b60011c8060205290600052602060002060005b8281101563000001a257818160051c015481604001526020016300000182565b60206000526040016000f3",
+	)
+
+	// ERC20FacadeCodeHash is the code hash of the ERC-20 facades
+	ERC20FacadeCodeHash = crypto.Keccak256Hash(ERC20FacadeCode)
+
+	// The facades keep the storage layout of the OpenZeppelin ERC-20, with
+	// the decimals stored after the symbol
+	erc20BalancesSlot    = common.BigToHash(big.NewInt(0))
+	ERC20TotalSupplySlot = common.BigToHash(big.NewInt(2))
+	ERC20NameSlot        = common.BigToHash(big.NewInt(3))
+	ERC20SymbolSlot      = common.BigToHash(big.NewInt(4))
+	ERC20DecimalsSlot    = common.BigToHash(big.NewInt(5))
+)
+
+func init() {
+	var err error
+	ERC20ABI, err = abi.JSON(strings.NewReader(erc20ABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ERC20Address returns the address of the ERC-20 facade of a token
+func ERC20Address(symbol string) common.Address {
+	return common.BytesToAddress(address.Module(ModuleName, []byte("erc20/"+strings.ToLower(symbol))))
+}
+
+// ERC20BalanceSlot returns the storage slot of the balance of an account in
+// an ERC-20 facade
+func ERC20BalanceSlot(account common.Address) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(account.Bytes(), 32), erc20BalancesSlot.Bytes())
+}
+
+// ERC20StringSlots returns the storage of a string kept at slot, in the
+// solidity layout: strings up to 31 bytes are stored with their length in the
+// slot, longer strings are stored from the hash of the slot on.
+func ERC20StringSlots(slot common.Hash, value string) map[common.Hash]common.Hash {
+	data := []byte(value)
+	slots := make(map[common.Hash]common.Hash)
+	if len(data) < 32 {
+		word := make([]byte, 32)
+		copy(word, data)
+		word[31] = byte(len(data) * 2)
+		slots[slot] = common.BytesToHash(word)
+		return slots
+	}
+
+	slots[slot] = common.BigToHash(big.NewInt(int64(len(data)*2 + 1)))
+	start := crypto.Keccak256Hash(slot.Bytes()).Big()
+	for i := 0; i*32 < len(data); i++ {
+		word := make([]byte, 32)
+		copy(word, data[i*32:])
+		slots[common.BigToHash(new(big.Int).Add(start, big.NewInt(int64(i))))] = common.BytesToHash(word)
+	}
+	return slots
+}
+
+// ERC20ReadString reads a string stored at slot in the solidity layout
+func ERC20ReadString(slot common.Hash, getState func(common.Hash) common.Hash) string {
+	word := getState(slot)
+	if word[31]%2 == 0 {
+		length := int(word[31] / 2)
+		return string(word[:length])
+	}
+
+	length := int(new(big.Int).Div(word.Big(), big.NewInt(2)).Int64())
+	data := make([]byte, 0, length+32)
+	start := crypto.Keccak256Hash(slot.Bytes()).Big()
+	for i := 0; i*32 < length; i++ {
+		next := getState(common.BigToHash(new(big.Int).Add(start, big.NewInt(int64(i)))))
+		data = append(data, next.Bytes()...)
+	}
+	return string(data[:length])
+}
@@ -0,0 +1,19 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/realiotech/realio-network/x/asset/types"
+)
+
+func TestERC20FacadeCode(t *testing.T) {
+	compiler := asm.NewCompiler(false)
+	compiler.Feed(asm.Lex([]byte(types.ERC20FacadeAsm), false))
+	code, errs := compiler.Compile()
+	require.Empty(t, errs)
+	require.Equal(t, code, common.Bytes2Hex(types.ERC20FacadeCode))
+}
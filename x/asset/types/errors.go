@@ -8,8 +8,23 @@ import (
 
 // x/asset module sentinel errors
 var (
-	ErrSample               = sdkerrors.Register(ModuleName, 1100, "sample error")
-	ErrInvalidPacketTimeout = sdkerrors.Register(ModuleName, 1500, "invalid packet timeout")
-	ErrInvalidVersion       = sdkerrors.Register(ModuleName, 1501, "invalid version")
-	ErrNotAuthorized        = sdkerrors.Register(ModuleName, 1502, "transaction not authorized")
+	ErrSample                 = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrInvalidPacketTimeout   = sdkerrors.Register(ModuleName, 1500, "invalid packet timeout")
+	ErrInvalidVersion         = sdkerrors.Register(ModuleName, 1501, "invalid version")
+	ErrNotAuthorized          = sdkerrors.Register(ModuleName, 1502, "transaction not authorized")
+	ErrMaxSupplyExceeded      = sdkerrors.Register(ModuleName, 1503, "max supply exceeded")
+	ErrAddressFrozen          = sdkerrors.Register(ModuleName, 1504, "address is frozen")
+	ErrTokenPaused            = sdkerrors.Register(ModuleName, 1505, "token is paused")
+	ErrClawbackDisabled       = sdkerrors.Register(ModuleName, 1506, "clawback is not enabled for token")
+	ErrMaxHoldersExceeded     = sdkerrors.Register(ModuleName, 1507, "max holders exceeded")
+	ErrMaxBalanceExceeded     = sdkerrors.Register(ModuleName, 1508, "max balance per holder exceeded")
+	ErrTransferBlackout       = sdkerrors.Register(ModuleName, 1509, "transfers are blocked by a blackout window")
+	ErrUnknownComplianceRule  = sdkerrors.Register(ModuleName, 1510, "unknown compliance rule")
+	ErrJurisdictionNotAllowed = sdkerrors.Register(ModuleName, 1511, "jurisdiction not allowed")
+	ErrAddressDenied          = sdkerrors.Register(ModuleName, 1512, "address is denied")
+	ErrIBCTransferNotAllowed  = sdkerrors.Register(ModuleName, 1513, "ibc transfer not allowed")
+	ErrReplicatedToken        = sdkerrors.Register(ModuleName, 1514, "token is replicated from another chain")
+	ErrInsufficientEscrow     = sdkerrors.Register(ModuleName, 1515, "insufficient escrow")
+	ErrHolderNotEligible      = sdkerrors.Register(ModuleName, 1516, "holder is not eligible")
+	ErrChannelNotApproved     = sdkerrors.Register(ModuleName, 1517, "channel is not approved")
 )
@@ -2,14 +2,83 @@ package types
 
 // staking module event types
 const (
-	EventTypeTokenCreated      = "create_token"
-	EventTypeTokenUpdated      = "update_token"
-	EventTypeTokenAuthorized   = "authorize_token"
-	EventTypeTokenUnAuthorized = "unauthorize_token"
+	EventTypeTokenCreated         = "create_token"
+	EventTypeTokenUpdated         = "update_token"
+	EventTypeTokenAuthorized      = "authorize_token"
+	EventTypeTokenUnAuthorized    = "unauthorize_token"
+	EventTypeTokenMinted          = "mint_token"
+	EventTypeTokenBurned          = "burn_token"
+	EventTypeRedemptionRequest    = "request_redemption"
+	EventTypeTokenRedeemed        = "redeem_token"
+	EventTypeRedemptionCancelled  = "cancel_redemption"
+	EventTypeManagerProposed      = "propose_manager"
+	EventTypeManagerAccepted      = "accept_manager"
+	EventTypeManagerCancelled     = "cancel_manager_proposal"
+	EventTypeRoleGranted          = "grant_role"
+	EventTypeRoleRevoked          = "revoke_role"
+	EventTypeAddressFrozen        = "freeze_address"
+	EventTypeAddressUnfrozen      = "unfreeze_address"
+	EventTypeTokenPaused          = "pause_token"
+	EventTypeTokenUnpaused        = "unpause_token"
+	EventTypeForceTransfer        = "force_transfer"
+	EventTypeAuthorizationExpired = "authorization_expired"
+	EventTypeHolderLimitsUpdated  = "update_holder_limits"
+	EventTypeBlackoutScheduleSet  = "set_blackout_schedule"
+	EventTypeComplianceRulesSet   = "set_compliance_rules"
+	EventTypeJurisdictionSet      = "set_jurisdiction"
+	EventTypeRestrictionModeSet   = "set_restriction_mode"
+	EventTypeAddressDenied        = "deny_address"
+	EventTypeAddressUndenied      = "undeny_address"
+	EventTypeIBCEnabledSet        = "set_ibc_enabled"
+	EventTypeReplicateToken       = "replicate_token"
+	EventTypeSendToken            = "send_token"
+	EventTypeTokenReplicated      = "token_replicated"
+	EventTypeTokenReceived        = "receive_token"
+	EventTypeTokenRefunded        = "refund_token"
+	EventTypeDistribute           = "distribute"
+	EventTypeDistributionPaid     = "distribution_paid"
+	EventTypeDistributionHeld     = "distribution_held"
+	EventTypeDistributionForfeit  = "distribution_forfeited"
+	EventTypeCreateSnapshot       = "create_snapshot"
+	EventTypeSplitToken           = "split_token"
+	EventTypeSplitBalance         = "split_balance"
+	EventTypeCreateSettlement     = "create_settlement"
+	EventTypeSettle               = "settle"
+	EventTypeSettlementExpired    = "settlement_expired"
+	EventTypeSettlementCancelled  = "cancel_settlement"
 
 	AttributeKeySymbol  = "symbol"
 	AttributeKeyIndex   = "index"
 	AttributeKeyAddress = "address"
+	AttributeKeyManager = "manager"
+	AttributeKeyRole    = "role"
+	AttributeKeyFrom    = "from"
+	AttributeKeyTo      = "to"
+	AttributeKeyReason  = "reason"
+
+	AttributeKeyMaxHolders          = "max_holders"
+	AttributeKeyMaxBalancePerHolder = "max_balance_per_holder"
+	AttributeKeyWindows             = "windows"
+	AttributeKeyRules               = "rules"
+	AttributeKeyJurisdiction        = "jurisdiction"
+	AttributeKeyMode                = "mode"
+	AttributeKeyEnabled             = "enabled"
+	AttributeKeyChannel             = "channel"
+	AttributeKeySequence            = "sequence"
+	AttributeKeyAckError            = "error"
+	AttributeKeyDistribution        = "distribution"
+	AttributeKeyPolicy              = "policy"
+	AttributeKeySnapshot            = "snapshot"
+	AttributeKeyHeight              = "height"
+	AttributeKeyNumerator           = "numerator"
+	AttributeKeyDenominator         = "denominator"
+	AttributeKeyTotal               = "total"
+	AttributeKeyBalanceBefore       = "balance_before"
+	AttributeKeyBalanceAfter        = "balance_after"
+	AttributeKeySettlement          = "settlement"
+	AttributeKeyCreator             = "creator"
+	AttributeKeyCounterparty        = "counterparty"
+	AttributeKeyExpiry              = "expiry"
 
 	AttributeValueCategory = ModuleName
 )
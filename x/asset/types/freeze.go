@@ -0,0 +1,8 @@
+package types
+
+func NewFrozenAddress(symbol string, address string) FrozenAddress {
+	return FrozenAddress{
+		Symbol:  symbol,
+		Address: address,
+	}
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -7,8 +13,26 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
-		Tokens: []Token{},
+		Params:      DefaultParams(),
+		Tokens:      []Token{},
+		Redemptions: []RedemptionRequest{},
+		Roles:       []RoleAssignment{},
+		Frozen:      []FrozenAddress{},
+		// authorizations are indexed by symbol/address
+		Authorizations: []TokenAuthorization{},
+		Jurisdictions:  []AddressJurisdiction{},
+		Denied:         []DeniedAddress{},
+		PortId:         PortID,
+		Escrows:        []ChannelEscrow{},
+		// distributions are indexed by id, their claims by holder/id
+		Distributions:             []Distribution{},
+		DistributionClaims:        []DistributionClaim{},
+		SettledDistributionClaims: []DistributionClaim{},
+		// snapshots are indexed by symbol/id, their balances by symbol/address/id
+		Snapshots:        []Snapshot{},
+		SnapshotBalances: []SnapshotBalance{},
+		// settlements are indexed by id
+		Settlements: []Settlement{},
 	}
 }
 
@@ -16,6 +40,41 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+
+	distributions := make(map[uint64]bool)
+	for _, distribution := range gs.Distributions {
+		if distribution.Id >= gs.DistributionCount {
+			return fmt.Errorf("distribution %d is not below the distribution count %d", distribution.Id, gs.DistributionCount)
+		}
+		if distributions[distribution.Id] {
+			return fmt.Errorf("duplicated distribution %d", distribution.Id)
+		}
+		distributions[distribution.Id] = true
+	}
+	for _, claim := range gs.DistributionClaims {
+		if !distributions[claim.DistributionId] {
+			return fmt.Errorf("claim of %s for unknown distribution %d", claim.Holder, claim.DistributionId)
+		}
+	}
+	for _, claim := range gs.SettledDistributionClaims {
+		if !distributions[claim.DistributionId] {
+			return fmt.Errorf("settled claim of %s for unknown distribution %d", claim.Holder, claim.DistributionId)
+		}
+	}
+
+	settlements := make(map[uint64]bool)
+	for _, settlement := range gs.Settlements {
+		if settlement.Id >= gs.SettlementCount {
+			return fmt.Errorf("settlement %d is not below the settlement count %d", settlement.Id, gs.SettlementCount)
+		}
+		if settlements[settlement.Id] {
+			return fmt.Errorf("duplicated settlement %d", settlement.Id)
+		}
+		settlements[settlement.Id] = true
+	}
 
 	return gs.Params.Validate()
 }
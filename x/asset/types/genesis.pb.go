@@ -28,6 +28,38 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// registered tokens
 	Tokens []Token `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens"`
+	// pending redemption requests
+	Redemptions []RedemptionRequest `protobuf:"bytes,3,rep,name=redemptions,proto3" json:"redemptions"`
+	// delegated token roles
+	Roles []RoleAssignment `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles"`
+	// frozen token holders
+	Frozen []FrozenAddress `protobuf:"bytes,5,rep,name=frozen,proto3" json:"frozen"`
+	// authorized token holders
+	Authorizations []TokenAuthorization `protobuf:"bytes,6,rep,name=authorizations,proto3" json:"authorizations"`
+	// jurisdictions of token holders
+	Jurisdictions []AddressJurisdiction `protobuf:"bytes,7,rep,name=jurisdictions,proto3" json:"jurisdictions"`
+	// denied addresses of denylist tokens
+	Denied []DeniedAddress `protobuf:"bytes,8,rep,name=denied,proto3" json:"denied"`
+	// port the asset ibc application binds to
+	PortId string `protobuf:"bytes,9,opt,name=portId,proto3" json:"portId,omitempty"`
+	// units of tokens escrowed on asset channels
+	Escrows []ChannelEscrow `protobuf:"bytes,10,rep,name=escrows,proto3" json:"escrows"`
+	// distributions being allocated or with pending claims
+	Distributions []Distribution `protobuf:"bytes,11,rep,name=distributions,proto3" json:"distributions"`
+	// pending distribution claims
+	DistributionClaims []DistributionClaim `protobuf:"bytes,12,rep,name=distributionClaims,proto3" json:"distributionClaims"`
+	// number of distributions created, the id of the next one
+	DistributionCount uint64 `protobuf:"varint,13,opt,name=distributionCount,proto3" json:"distributionCount,omitempty"`
+	// balance snapshots of tokens
+	Snapshots []Snapshot `protobuf:"bytes,14,rep,name=snapshots,proto3" json:"snapshots"`
+	// balances recorded for snapshots
+	SnapshotBalances []SnapshotBalance `protobuf:"bytes,15,rep,name=snapshotBalances,proto3" json:"snapshotBalances"`
+	// pending settlements
+	Settlements []Settlement `protobuf:"bytes,16,rep,name=settlements,proto3" json:"settlements"`
+	// number of settlements created, the id of the next one
+	SettlementCount uint64 `protobuf:"varint,17,opt,name=settlementCount,proto3" json:"settlementCount,omitempty"`
+	// distribution shares settled while their distribution is allocated
+	SettledDistributionClaims []DistributionClaim `protobuf:"bytes,18,rep,name=settledDistributionClaims,proto3" json:"settledDistributionClaims"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +109,118 @@ func (m *GenesisState) GetTokens() []Token {
 	return nil
 }
 
+func (m *GenesisState) GetRedemptions() []RedemptionRequest {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoles() []RoleAssignment {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozen() []FrozenAddress {
+	if m != nil {
+		return m.Frozen
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthorizations() []TokenAuthorization {
+	if m != nil {
+		return m.Authorizations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetJurisdictions() []AddressJurisdiction {
+	if m != nil {
+		return m.Jurisdictions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenied() []DeniedAddress {
+	if m != nil {
+		return m.Denied
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *GenesisState) GetEscrows() []ChannelEscrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributions() []Distribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionClaims() []DistributionClaim {
+	if m != nil {
+		return m.DistributionClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionCount() uint64 {
+	if m != nil {
+		return m.DistributionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSnapshots() []Snapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSnapshotBalances() []SnapshotBalance {
+	if m != nil {
+		return m.SnapshotBalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettlements() []Settlement {
+	if m != nil {
+		return m.Settlements
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettlementCount() uint64 {
+	if m != nil {
+		return m.SettlementCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSettledDistributionClaims() []DistributionClaim {
+	if m != nil {
+		return m.SettledDistributionClaims
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "realionetwork.asset.v1.GenesisState")
 }
@@ -86,23 +230,49 @@ func init() {
 }
 
 var fileDescriptor_438378d3b45a7c56 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x4a, 0x4d, 0xcc,
-	0xc9, 0xcc, 0xcf, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x43, 0x51, 0xa5, 0x07, 0x56, 0xa5, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
-	0x0f, 0x56, 0xa2, 0x0f, 0x62, 0x41, 0x54, 0x4b, 0x29, 0xe3, 0x30, 0xb3, 0x20, 0xb1, 0x28, 0x31,
-	0x17, 0x6a, 0xa4, 0x94, 0x12, 0x0e, 0x45, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0x10, 0x35, 0x4a, 0x9d,
-	0x8c, 0x5c, 0x3c, 0xee, 0x10, 0x87, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xd9, 0x70, 0xb1, 0x41,
-	0x0c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd3, 0xc3, 0xee, 0x30, 0xbd, 0x00, 0xb0,
-	0x2a, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x7a, 0x84, 0xac, 0xb9, 0xd8, 0xc0, 0xa6,
-	0x17, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0xc9, 0xe2, 0xd2, 0x1d, 0x02, 0x52, 0x05, 0xd3,
-	0x0c, 0xd1, 0xe2, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
-	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x46,
-	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10, 0x03, 0x4b, 0x52, 0x93,
-	0x33, 0xa0, 0x4c, 0x5d, 0x98, 0x07, 0x2b, 0xa0, 0x5e, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62,
-	0x03, 0x7b, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x31, 0x93, 0xd6, 0x7f, 0x01, 0x00,
-	0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4d, 0x53, 0x13, 0x31,
+	0x18, 0xc7, 0xbb, 0x02, 0x45, 0x52, 0x5e, 0x33, 0x0e, 0x13, 0x99, 0x71, 0xad, 0x08, 0x52, 0x7c,
+	0x69, 0x07, 0x3c, 0xea, 0x85, 0x52, 0x74, 0x64, 0x3c, 0x60, 0x71, 0xc6, 0x97, 0x8b, 0xb3, 0x74,
+	0x1f, 0xda, 0xc8, 0x36, 0xa9, 0x79, 0x52, 0x10, 0x3e, 0x85, 0xdf, 0xc1, 0x2f, 0xc3, 0x91, 0xa3,
+	0x27, 0xc7, 0x81, 0x2f, 0xe2, 0x34, 0x9b, 0xa5, 0xdb, 0x96, 0xb4, 0x8e, 0xb7, 0xdd, 0xf4, 0xf7,
+	0xff, 0x25, 0x79, 0xf6, 0x49, 0x4a, 0x56, 0x14, 0x04, 0x11, 0x97, 0x02, 0xf4, 0x89, 0x54, 0x47,
+	0xa5, 0x00, 0x11, 0x74, 0xe9, 0x78, 0xa3, 0x54, 0x07, 0x01, 0xc8, 0xb1, 0xd8, 0x52, 0x52, 0x4b,
+	0xba, 0xd8, 0x43, 0x15, 0x0d, 0x55, 0x3c, 0xde, 0x58, 0xba, 0x53, 0x97, 0x75, 0x69, 0x90, 0x52,
+	0xe7, 0x29, 0xa6, 0x97, 0x1e, 0x3a, 0x9c, 0xad, 0x40, 0x05, 0x4d, 0xab, 0x5c, 0x5a, 0x76, 0x40,
+	0x5a, 0x1e, 0x81, 0xb0, 0xcc, 0x9a, 0x83, 0x51, 0x10, 0x42, 0xb3, 0xa5, 0xb9, 0x4c, 0xc0, 0x07,
+	0x2e, 0x50, 0x46, 0x30, 0x62, 0x51, 0x87, 0x0a, 0xe0, 0x2c, 0x81, 0x4a, 0xc3, 0x16, 0x15, 0xb4,
+	0x75, 0x43, 0x2a, 0x7e, 0x16, 0xa4, 0x26, 0x5e, 0x77, 0x04, 0xbe, 0xb6, 0x15, 0xc7, 0x90, 0xd7,
+	0x52, 0xe8, 0xaa, 0x03, 0x0d, 0x41, 0x9c, 0x46, 0x1c, 0xf5, 0xc8, 0xe2, 0xd5, 0x8e, 0x40, 0x8f,
+	0x98, 0x36, 0xe4, 0xa8, 0x15, 0x3f, 0x68, 0xff, 0xc3, 0xb4, 0x28, 0x82, 0x16, 0x36, 0xa4, 0x1e,
+	0x51, 0x6a, 0x04, 0xad, 0x23, 0x68, 0x82, 0xb0, 0xe0, 0xf2, 0x4f, 0x42, 0xa6, 0x5f, 0xc7, 0xcd,
+	0xb1, 0xaf, 0x03, 0x0d, 0xf4, 0x25, 0xc9, 0xc6, 0x1f, 0x96, 0x79, 0x79, 0xaf, 0x90, 0xdb, 0xf4,
+	0x8b, 0x37, 0x37, 0x4b, 0x71, 0xcf, 0x50, 0xe5, 0xf1, 0xf3, 0xdf, 0xf7, 0x33, 0x55, 0x9b, 0xa1,
+	0x2f, 0x48, 0xd6, 0x14, 0x17, 0xd9, 0xad, 0xfc, 0x58, 0x21, 0xb7, 0x79, 0xcf, 0x95, 0x7e, 0xdf,
+	0xa1, 0x92, 0x70, 0x1c, 0xa1, 0xef, 0x48, 0xae, 0xdb, 0x0a, 0xc8, 0xc6, 0x8c, 0x61, 0xdd, 0x65,
+	0xa8, 0x5e, 0xa3, 0x55, 0xf8, 0xd6, 0x06, 0xd4, 0xd6, 0x96, 0x76, 0xd0, 0x32, 0x99, 0xe8, 0x34,
+	0x0d, 0xb2, 0x71, 0x23, 0x7b, 0xe4, 0x94, 0xc9, 0x08, 0xb6, 0x10, 0x79, 0x5d, 0x74, 0x6a, 0x63,
+	0x4d, 0x71, 0x94, 0x6e, 0x93, 0xec, 0xa1, 0x92, 0x67, 0x20, 0xd8, 0x84, 0x91, 0xac, 0xba, 0x24,
+	0xaf, 0x0c, 0xb5, 0x15, 0x86, 0x0a, 0xf0, 0xba, 0x30, 0x71, 0x94, 0x7e, 0x24, 0xb3, 0x3d, 0x0d,
+	0x87, 0x2c, 0x6b, 0x64, 0x8f, 0x87, 0x16, 0x68, 0x2b, 0x1d, 0xb1, 0xc6, 0x3e, 0x0f, 0xfd, 0x40,
+	0x66, 0xd2, 0xed, 0x89, 0x6c, 0xd2, 0x88, 0x9f, 0xb8, 0xc4, 0x76, 0x7d, 0xbb, 0xa9, 0x8c, 0x35,
+	0xf7, 0x7a, 0x3a, 0xfb, 0x0e, 0x41, 0x70, 0x08, 0xd9, 0xed, 0xe1, 0xfb, 0xae, 0x18, 0xaa, 0x6f,
+	0xdf, 0x71, 0x94, 0x2e, 0x92, 0x6c, 0x4b, 0x2a, 0xfd, 0x26, 0x64, 0x53, 0x79, 0xaf, 0x30, 0x55,
+	0xb5, 0x6f, 0x74, 0x87, 0x4c, 0x02, 0xd6, 0x94, 0x3c, 0x41, 0x46, 0x86, 0xdb, 0xb7, 0x1b, 0x81,
+	0x10, 0x10, 0xed, 0x18, 0xda, 0xda, 0x93, 0x2c, 0xdd, 0x23, 0x33, 0xe9, 0x43, 0x82, 0x2c, 0x67,
+	0x64, 0x2b, 0xce, 0xa5, 0xa6, 0xe0, 0x64, 0xd7, 0x3d, 0x02, 0xfa, 0x85, 0xd0, 0xf4, 0xc0, 0x76,
+	0x14, 0xf0, 0x26, 0xb2, 0xe9, 0xe1, 0xbd, 0x58, 0xe9, 0x4f, 0x58, 0xf7, 0x0d, 0x2a, 0xfa, 0x94,
+	0x2c, 0xf4, 0x8c, 0xca, 0xb6, 0xd0, 0x6c, 0x26, 0xef, 0x15, 0xc6, 0xab, 0x83, 0x3f, 0xd0, 0x0a,
+	0x99, 0x4a, 0x8e, 0x36, 0xb2, 0x59, 0xb3, 0x8a, 0xbc, 0x6b, 0x15, 0xfb, 0x16, 0xb4, 0x93, 0x77,
+	0x83, 0xf4, 0x13, 0x99, 0x4f, 0x5e, 0xca, 0x41, 0x14, 0x88, 0x1a, 0x20, 0x9b, 0x33, 0xb2, 0xb5,
+	0x91, 0xb2, 0x98, 0xb7, 0xce, 0x01, 0x0d, 0xdd, 0x25, 0xb9, 0xee, 0xa5, 0x82, 0x6c, 0xde, 0x58,
+	0x97, 0x9d, 0xd6, 0x6b, 0x34, 0x39, 0xad, 0xa9, 0x30, 0x2d, 0x90, 0xb9, 0xee, 0x6b, 0x5c, 0x98,
+	0x05, 0x53, 0x98, 0xfe, 0x61, 0xda, 0x24, 0x77, 0xe3, 0xa1, 0xb0, 0x32, 0xf8, 0xb1, 0xe8, 0xff,
+	0x7d, 0x2c, 0xb7, 0xb1, 0xfc, 0xf6, 0xfc, 0xd2, 0xf7, 0x2e, 0x2e, 0x7d, 0xef, 0xcf, 0xa5, 0xef,
+	0xfd, 0xb8, 0xf2, 0x33, 0x17, 0x57, 0x7e, 0xe6, 0xd7, 0x95, 0x9f, 0xf9, 0xbc, 0x59, 0xe7, 0xba,
+	0xd1, 0x3e, 0x28, 0xd6, 0x64, 0xd3, 0xfe, 0xdb, 0x68, 0xa8, 0x35, 0xec, 0xe3, 0xb3, 0xe4, 0xfe,
+	0xfd, 0x6e, 0x6f, 0x60, 0x7d, 0xda, 0x02, 0x3c, 0xc8, 0x9a, 0xab, 0xf7, 0xf9, 0xdf, 0x01, 0x00,
+	0x86, 0x1f, 0xac, 0x05, 0xad, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +295,211 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SettledDistributionClaims) > 0 {
+		for iNdEx := len(m.SettledDistributionClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledDistributionClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.SettlementCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SettlementCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.Settlements) > 0 {
+		for iNdEx := len(m.Settlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Settlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.SnapshotBalances) > 0 {
+		for iNdEx := len(m.SnapshotBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SnapshotBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.DistributionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DistributionCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.DistributionClaims) > 0 {
+		for iNdEx := len(m.DistributionClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Denied) > 0 {
+		for iNdEx := len(m.Denied) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denied[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Jurisdictions) > 0 {
+		for iNdEx := len(m.Jurisdictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jurisdictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Frozen) > 0 {
+		for iNdEx := len(m.Frozen) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Frozen[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Tokens) > 0 {
 		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,45 +552,133 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if len(m.Frozen) > 0 {
+		for _, e := range m.Frozen {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
-		switch fieldNum {
-		case 1:
+	}
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Jurisdictions) > 0 {
+		for _, e := range m.Jurisdictions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Denied) > 0 {
+		for _, e := range m.Denied {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionClaims) > 0 {
+		for _, e := range m.DistributionClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DistributionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DistributionCount))
+	}
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SnapshotBalances) > 0 {
+		for _, e := range m.SnapshotBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Settlements) > 0 {
+		for _, e := range m.Settlements {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SettlementCount != 0 {
+		n += 2 + sovGenesis(uint64(m.SettlementCount))
+	}
+	if len(m.SettledDistributionClaims) > 0 {
+		for _, e := range m.SettledDistributionClaims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
@@ -282,6 +745,518 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, RedemptionRequest{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, RoleAssignment{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frozen = append(m.Frozen, FrozenAddress{})
+			if err := m.Frozen[len(m.Frozen)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, TokenAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdictions = append(m.Jurisdictions, AddressJurisdiction{})
+			if err := m.Jurisdictions[len(m.Jurisdictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denied", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denied = append(m.Denied, DeniedAddress{})
+			if err := m.Denied[len(m.Denied)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, ChannelEscrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, Distribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionClaims = append(m.DistributionClaims, DistributionClaim{})
+			if err := m.DistributionClaims[len(m.DistributionClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCount", wireType)
+			}
+			m.DistributionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, Snapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotBalances = append(m.SnapshotBalances, SnapshotBalance{})
+			if err := m.SnapshotBalances[len(m.SnapshotBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settlements = append(m.Settlements, Settlement{})
+			if err := m.Settlements[len(m.Settlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementCount", wireType)
+			}
+			m.SettlementCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettlementCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledDistributionClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledDistributionClaims = append(m.SettledDistributionClaims, DistributionClaim{})
+			if err := m.SettledDistributionClaims[len(m.SettledDistributionClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
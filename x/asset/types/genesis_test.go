@@ -22,11 +22,49 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				PortId: types.PortID,
 				Tokens: []types.Token{},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "invalid port",
+			genState: &types.GenesisState{
+				PortId: "",
+				Tokens: []types.Token{},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid replica channel",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				Params: types.NewParams([]string{"channel-0", "channel-0"}),
+			},
+			valid: false,
+		},
+		{
+			desc: "claim of unknown distribution",
+			genState: &types.GenesisState{
+				PortId:            types.PortID,
+				Distributions:     []types.Distribution{{Id: 0}},
+				DistributionCount: 1,
+				DistributionClaims: []types.DistributionClaim{
+					{DistributionId: 1, Holder: "holder"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "settlement above the settlement count",
+			genState: &types.GenesisState{
+				PortId:          types.PortID,
+				Settlements:     []types.Settlement{{Id: 1}},
+				SettlementCount: 1,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,9 @@
+package types
+
+func NewAddressJurisdiction(symbol string, address string, jurisdiction string) AddressJurisdiction {
+	return AddressJurisdiction{
+		Symbol:       symbol,
+		Address:      address,
+		Jurisdiction: jurisdiction,
+	}
+}
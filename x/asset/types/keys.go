@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "asset"
@@ -16,6 +22,9 @@ const (
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_asset"
 
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_asset"
+
 	// Version defines the current version the IBC module supports
 	Version = "asset-1"
 
@@ -24,6 +33,87 @@ const (
 
 	// TokenKeyPrefix is the prefix to retrieve all Token
 	TokenKeyPrefix = "Token/value/"
+
+	// RedemptionKeyPrefix is the prefix to retrieve all RedemptionRequest
+	RedemptionKeyPrefix = "Redemption/value/"
+
+	// RoleKeyPrefix is the prefix to retrieve all RoleAssignment
+	RoleKeyPrefix = "Role/value/"
+
+	// FrozenKeyPrefix is the prefix to retrieve all FrozenAddress
+	FrozenKeyPrefix = "Frozen/value/"
+
+	// AuthorizationKeyPrefix is the prefix to retrieve all TokenAuthorization
+	AuthorizationKeyPrefix = "Authorization/value/"
+
+	// AddressAuthorizationKeyPrefix is the prefix of the address/symbol index of TokenAuthorization
+	AddressAuthorizationKeyPrefix = "AddressAuthorization/value/"
+
+	// AuthorizationExpiryKeyPrefix is the prefix of the expiry queue of TokenAuthorization
+	AuthorizationExpiryKeyPrefix = "AuthorizationExpiry/value/"
+
+	// HolderKeyPrefix is the prefix of the set of accounts holding a token
+	HolderKeyPrefix = "Holder/value/"
+
+	// HolderCountKeyPrefix is the prefix to retrieve the number of holders of a token
+	HolderCountKeyPrefix = "HolderCount/value/"
+
+	// JurisdictionKeyPrefix is the prefix to retrieve all AddressJurisdiction
+	JurisdictionKeyPrefix = "Jurisdiction/value/"
+
+	// DeniedKeyPrefix is the prefix to retrieve all DeniedAddress
+	DeniedKeyPrefix = "Denied/value/"
+
+	// EscrowKeyPrefix is the prefix to retrieve all ChannelEscrow
+	EscrowKeyPrefix = "Escrow/value/"
+
+	// ERC20KeyPrefix is the prefix to retrieve the symbol of an ERC-20 facade
+	ERC20KeyPrefix = "ERC20/value/"
+
+	// DistributionKeyPrefix is the prefix to retrieve all Distribution
+	DistributionKeyPrefix = "Distribution/value/"
+
+	// DistributionCountKey is the key to retrieve the number of distributions created
+	DistributionCountKey = "Distribution/count/"
+
+	// DistributionClaimKeyPrefix is the prefix to retrieve all DistributionClaim
+	DistributionClaimKeyPrefix = "DistributionClaim/value/"
+
+	// DistributionPayoutKeyPrefix is the prefix of the queue of claims paid out by the EndBlocker
+	DistributionPayoutKeyPrefix = "DistributionPayout/value/"
+
+	// DistributionAllocationKeyPrefix is the prefix of the queue of distributions allocated by the EndBlocker
+	DistributionAllocationKeyPrefix = "DistributionAllocation/value/"
+
+	// DistributionSettledKeyPrefix is the prefix of the claims settled while their distribution is allocated
+	DistributionSettledKeyPrefix = "DistributionSettled/value/"
+
+	// DistributionCreatedKeyPrefix is the transient prefix of the distributions created during the block
+	DistributionCreatedKeyPrefix = "DistributionCreated/value/"
+
+	// SnapshotKeyPrefix is the prefix to retrieve all Snapshot
+	SnapshotKeyPrefix = "Snapshot/value/"
+
+	// SnapshotCountKeyPrefix is the prefix to retrieve the number of snapshots of a token
+	SnapshotCountKeyPrefix = "Snapshot/count/"
+
+	// SnapshotBalanceKeyPrefix is the prefix to retrieve all SnapshotBalance
+	SnapshotBalanceKeyPrefix = "SnapshotBalance/value/"
+
+	// SnapshotDeltaKeyPrefix is the transient prefix of the balance changes of snapshotted tokens during the block
+	SnapshotDeltaKeyPrefix = "SnapshotDelta/value/"
+
+	// SettlementKeyPrefix is the prefix to retrieve all Settlement
+	SettlementKeyPrefix = "Settlement/value/"
+
+	// SettlementCountKey is the key to retrieve the number of settlements created
+	SettlementCountKey = "Settlement/count/"
+
+	// SettlementExpiryKeyPrefix is the prefix of the expiry queue of Settlement
+	SettlementExpiryKeyPrefix = "SettlementExpiry/value/"
+
+	// TransferEscrowKeyPrefix is the prefix of the set of ICS-20 escrow accounts
+	TransferEscrowKeyPrefix = "TransferEscrow/value/"
 )
 
 // PortKey defines the key to store the port ID in store
@@ -45,3 +135,294 @@ func TokenKey(
 
 	return key
 }
+
+// RedemptionKey returns the store key to retrieve a RedemptionRequest from the index fields
+func RedemptionKey(
+	symbol string,
+	holder string,
+) []byte {
+	var key []byte
+
+	key = append(key, TokenKey(symbol)...)
+	key = append(key, []byte(holder)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// RoleKey returns the store key to retrieve a RoleAssignment from the index fields
+func RoleKey(
+	symbol string,
+	address string,
+	role Role,
+) []byte {
+	var key []byte
+
+	key = append(key, TokenKey(symbol)...)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(role.String())...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// FrozenKey returns the store key to retrieve a FrozenAddress from the index fields
+func FrozenKey(
+	symbol string,
+	address string,
+) []byte {
+	var key []byte
+
+	key = append(key, TokenKey(symbol)...)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AuthorizationKey returns the store key to retrieve a TokenAuthorization from the index fields
+func AuthorizationKey(
+	symbol string,
+	address string,
+) []byte {
+	var key []byte
+
+	key = append(key, TokenKey(symbol)...)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AddressAuthorizationKey returns the index key of a TokenAuthorization by address
+func AddressAuthorizationKey(
+	address string,
+	symbol string,
+) []byte {
+	var key []byte
+
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+	key = append(key, TokenKey(symbol)...)
+
+	return key
+}
+
+// AuthorizationExpiryKey returns the expiry queue key of a TokenAuthorization, ordered by expiry time
+func AuthorizationExpiryKey(
+	expiry time.Time,
+	symbol string,
+	address string,
+) []byte {
+	var key []byte
+
+	key = append(key, sdk.FormatTimeBytes(expiry)...)
+	key = append(key, []byte("/")...)
+	key = append(key, AuthorizationKey(symbol, address)...)
+
+	return key
+}
+
+// HolderKey returns the store key of an account holding a token
+func HolderKey(
+	symbol string,
+	address string,
+) []byte {
+	var key []byte
+
+	key = append(key, TokenKey(symbol)...)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// JurisdictionKey returns the store key to retrieve an AddressJurisdiction from the index fields
+func JurisdictionKey(
+	symbol string,
+	address string,
+) []byte {
+	var key []byte
+
+	key = append(key, TokenKey(symbol)...)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DeniedKey returns the store key to retrieve a DeniedAddress from the index fields
+func DeniedKey(
+	symbol string,
+	address string,
+) []byte {
+	var key []byte
+
+	key = append(key, TokenKey(symbol)...)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// EscrowKey returns the store key to retrieve a ChannelEscrow from the index fields
+func EscrowKey(
+	channel string,
+	symbol string,
+) []byte {
+	var key []byte
+
+	key = append(key, []byte(channel)...)
+	key = append(key, []byte("/")...)
+	key = append(key, TokenKey(symbol)...)
+
+	return key
+}
+
+// ERC20Key returns the store key to retrieve the symbol of an ERC-20 facade
+func ERC20Key(
+	address string,
+) []byte {
+	var key []byte
+
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DistributionKey returns the store key to retrieve a Distribution from its id
+func DistributionKey(
+	id uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// DistributionClaimKey returns the store key to retrieve a DistributionClaim from the index fields
+func DistributionClaimKey(
+	holder string,
+	id uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, []byte(holder)...)
+	key = append(key, []byte("/")...)
+	key = append(key, DistributionKey(id)...)
+
+	return key
+}
+
+// DistributionPayoutKey returns the payout queue key of a DistributionClaim, ordered by distribution
+func DistributionPayoutKey(
+	id uint64,
+	holder string,
+) []byte {
+	var key []byte
+
+	key = append(key, DistributionKey(id)...)
+	key = append(key, []byte(holder)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// SnapshotKey returns the store key to retrieve a Snapshot from the index fields
+func SnapshotKey(
+	symbol string,
+	id uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, TokenKey(symbol)...)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// SnapshotBalancePrefix returns the store prefix of the SnapshotBalance of an address, ordered by snapshot
+func SnapshotBalancePrefix(
+	symbol string,
+	address string,
+) []byte {
+	var key []byte
+
+	key = append(key, TokenKey(symbol)...)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// SnapshotBalanceKey returns the store key to retrieve a SnapshotBalance from the index fields
+func SnapshotBalanceKey(
+	symbol string,
+	address string,
+	id uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, SnapshotBalancePrefix(symbol, address)...)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// SnapshotDeltaKey returns the transient store key of the balance change of an address during the block
+func SnapshotDeltaKey(
+	symbol string,
+	address string,
+) []byte {
+	var key []byte
+
+	key = append(key, TokenKey(symbol)...)
+	key = append(key, []byte(address)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// SettlementKey returns the store key to retrieve a Settlement from its id
+func SettlementKey(
+	id uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// SettlementExpiryKey returns the expiry queue key of a Settlement, ordered by expiry time
+func SettlementExpiryKey(
+	expiry time.Time,
+	id uint64,
+) []byte {
+	var key []byte
+
+	key = append(key, sdk.FormatTimeBytes(expiry)...)
+	key = append(key, []byte("/")...)
+	key = append(key, SettlementKey(id)...)
+
+	return key
+}
+
+// TransferEscrowKey returns the store key of an ICS-20 escrow account
+func TransferEscrowKey(
+	address sdk.AccAddress,
+) []byte {
+	var key []byte
+
+	key = append(key, address.Bytes()...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
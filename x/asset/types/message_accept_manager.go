@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAcceptManager = "accept_manager"
+
+var _ sdk.Msg = &MsgAcceptManager{}
+
+func NewMsgAcceptManager(newManager string, symbol string) *MsgAcceptManager {
+	return &MsgAcceptManager{
+		NewManager: newManager,
+		Symbol:     symbol,
+	}
+}
+
+func (msg *MsgAcceptManager) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptManager) Type() string {
+	return TypeMsgAcceptManager
+}
+
+func (msg *MsgAcceptManager) GetSigners() []sdk.AccAddress {
+	newManager, err := sdk.AccAddressFromBech32(msg.NewManager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{newManager}
+}
+
+func (msg *MsgAcceptManager) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptManager) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.NewManager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new manager address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAffirmSettlement = "affirm_settlement"
+
+var _ sdk.Msg = &MsgAffirmSettlement{}
+
+func NewMsgAffirmSettlement(counterparty string, id uint64) *MsgAffirmSettlement {
+	return &MsgAffirmSettlement{
+		Counterparty: counterparty,
+		Id:           id,
+	}
+}
+
+func (msg *MsgAffirmSettlement) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAffirmSettlement) Type() string {
+	return TypeMsgAffirmSettlement
+}
+
+func (msg *MsgAffirmSettlement) GetSigners() []sdk.AccAddress {
+	counterparty, err := sdk.AccAddressFromBech32(msg.Counterparty)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{counterparty}
+}
+
+func (msg *MsgAffirmSettlement) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAffirmSettlement) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Counterparty); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid counterparty address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBatchAuthorize = "batch_authorize"
+
+var _ sdk.Msg = &MsgBatchAuthorize{}
+
+func NewMsgBatchAuthorize(manager string, symbol string, addresses []string) *MsgBatchAuthorize {
+	return &MsgBatchAuthorize{
+		Manager:   manager,
+		Symbol:    symbol,
+		Addresses: addresses,
+	}
+}
+
+func (msg *MsgBatchAuthorize) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchAuthorize) Type() string {
+	return TypeMsgBatchAuthorize
+}
+
+func (msg *MsgBatchAuthorize) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgBatchAuthorize) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBatchAuthorize) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid manager address (%s)", err)
+	}
+	return validateBatchAddresses(msg.Addresses)
+}
+
+// validateBatchAddresses checks that a batch holds at least one address and
+// that every address is valid and listed once, so that the batch is applied
+// as a whole or not at all.
+func validateBatchAddresses(addresses []string) error {
+	if len(addresses) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "addresses cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s (%s)", address, err)
+		}
+		if seen[address] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address %s", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
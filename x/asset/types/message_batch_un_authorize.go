@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBatchUnAuthorize = "batch_un_authorize"
+
+var _ sdk.Msg = &MsgBatchUnAuthorize{}
+
+func NewMsgBatchUnAuthorize(manager string, symbol string, addresses []string) *MsgBatchUnAuthorize {
+	return &MsgBatchUnAuthorize{
+		Manager:   manager,
+		Symbol:    symbol,
+		Addresses: addresses,
+	}
+}
+
+func (msg *MsgBatchUnAuthorize) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchUnAuthorize) Type() string {
+	return TypeMsgBatchUnAuthorize
+}
+
+func (msg *MsgBatchUnAuthorize) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgBatchUnAuthorize) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBatchUnAuthorize) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid manager address (%s)", err)
+	}
+	return validateBatchAddresses(msg.Addresses)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgBurnToken = "burn_token"
+
+var _ sdk.Msg = &MsgBurnToken{}
+
+func NewMsgBurnToken(holder string, symbol string, amount string) *MsgBurnToken {
+	return &MsgBurnToken{
+		Holder: holder,
+		Symbol: symbol,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgBurnToken) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBurnToken) Type() string {
+	return TypeMsgBurnToken
+}
+
+func (msg *MsgBurnToken) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{holder}
+}
+
+func (msg *MsgBurnToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBurnToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", err)
+	}
+	amount, ok := math.NewIntFromString(msg.Amount)
+	if !ok || !amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid burn amount %s", msg.Amount)
+	}
+
+	return nil
+}
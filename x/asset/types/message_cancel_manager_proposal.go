@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelManagerProposal = "cancel_manager_proposal"
+
+var _ sdk.Msg = &MsgCancelManagerProposal{}
+
+func NewMsgCancelManagerProposal(manager string, symbol string) *MsgCancelManagerProposal {
+	return &MsgCancelManagerProposal{
+		Manager: manager,
+		Symbol:  symbol,
+	}
+}
+
+func (msg *MsgCancelManagerProposal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelManagerProposal) Type() string {
+	return TypeMsgCancelManagerProposal
+}
+
+func (msg *MsgCancelManagerProposal) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgCancelManagerProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelManagerProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelRedemption = "cancel_redemption"
+
+var _ sdk.Msg = &MsgCancelRedemption{}
+
+func NewMsgCancelRedemption(holder string, symbol string) *MsgCancelRedemption {
+	return &MsgCancelRedemption{
+		Holder: holder,
+		Symbol: symbol,
+	}
+}
+
+func (msg *MsgCancelRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelRedemption) Type() string {
+	return TypeMsgCancelRedemption
+}
+
+func (msg *MsgCancelRedemption) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{holder}
+}
+
+func (msg *MsgCancelRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelRedemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", err)
+	}
+
+	return nil
+}
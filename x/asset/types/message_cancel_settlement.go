@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelSettlement = "cancel_settlement"
+
+var _ sdk.Msg = &MsgCancelSettlement{}
+
+func NewMsgCancelSettlement(creator string, id uint64) *MsgCancelSettlement {
+	return &MsgCancelSettlement{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func (msg *MsgCancelSettlement) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelSettlement) Type() string {
+	return TypeMsgCancelSettlement
+}
+
+func (msg *MsgCancelSettlement) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelSettlement) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelSettlement) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	return nil
+}
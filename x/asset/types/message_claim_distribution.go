@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgClaimDistribution = "claim_distribution"
+
+var _ sdk.Msg = &MsgClaimDistribution{}
+
+func NewMsgClaimDistribution(holder string, distributionID uint64) *MsgClaimDistribution {
+	return &MsgClaimDistribution{
+		Holder:         holder,
+		DistributionId: distributionID,
+	}
+}
+
+func (msg *MsgClaimDistribution) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimDistribution) Type() string {
+	return TypeMsgClaimDistribution
+}
+
+func (msg *MsgClaimDistribution) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{holder}
+}
+
+func (msg *MsgClaimDistribution) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimDistribution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateSettlement = "create_settlement"
+
+var _ sdk.Msg = &MsgCreateSettlement{}
+
+func NewMsgCreateSettlement(creator string, counterparty string, creatorLeg SettlementLeg, counterpartyLeg SettlementLeg, expiry time.Time) *MsgCreateSettlement {
+	return &MsgCreateSettlement{
+		Creator:         creator,
+		Counterparty:    counterparty,
+		CreatorLeg:      creatorLeg,
+		CounterpartyLeg: counterpartyLeg,
+		Expiry:          expiry,
+	}
+}
+
+func (msg *MsgCreateSettlement) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateSettlement) Type() string {
+	return TypeMsgCreateSettlement
+}
+
+func (msg *MsgCreateSettlement) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateSettlement) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateSettlement) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Counterparty); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid counterparty address: %s", err)
+	}
+	if msg.Creator == msg.Counterparty {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "creator and counterparty are the same address")
+	}
+	if err := validateSettlementLeg(msg.CreatorLeg); err != nil {
+		return err
+	}
+	if err := validateSettlementLeg(msg.CounterpartyLeg); err != nil {
+		return err
+	}
+	if strings.EqualFold(msg.CreatorLeg.Symbol, msg.CounterpartyLeg.Symbol) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "both legs deliver %s", msg.CreatorLeg.Symbol)
+	}
+	if msg.Expiry.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing settlement expiry")
+	}
+	return nil
+}
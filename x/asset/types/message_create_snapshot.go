@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateSnapshot = "create_snapshot"
+
+var _ sdk.Msg = &MsgCreateSnapshot{}
+
+func NewMsgCreateSnapshot(manager string, symbol string) *MsgCreateSnapshot {
+	return &MsgCreateSnapshot{
+		Manager: manager,
+		Symbol:  symbol,
+	}
+}
+
+func (msg *MsgCreateSnapshot) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateSnapshot) Type() string {
+	return TypeMsgCreateSnapshot
+}
+
+func (msg *MsgCreateSnapshot) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgCreateSnapshot) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateSnapshot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -45,5 +46,25 @@ func (msg *MsgCreateToken) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid manager address (%s)", err)
 	}
+	if err := ValidateSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	if _, err := ParseDecimals(msg.Decimals); err != nil {
+		return err
+	}
+	if msg.MaxSupply != "" {
+		if maxSupply, ok := math.NewIntFromString(msg.MaxSupply); !ok || maxSupply.IsNegative() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid max supply %s", msg.MaxSupply)
+		}
+	}
+	if err := validateMaxBalancePerHolder(msg.MaxBalancePerHolder); err != nil {
+		return err
+	}
+	if err := validateBlackoutWindows(msg.BlackoutWindows); err != nil {
+		return err
+	}
+	if msg.RestrictionMode != RestrictionModeUnspecified {
+		return validateRestrictionMode(msg.RestrictionMode)
+	}
 	return nil
 }
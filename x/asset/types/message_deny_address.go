@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDenyAddress = "deny_address"
+
+var _ sdk.Msg = &MsgDenyAddress{}
+
+func NewMsgDenyAddress(manager string, symbol string, address string) *MsgDenyAddress {
+	return &MsgDenyAddress{
+		Manager: manager,
+		Symbol:  symbol,
+		Address: address,
+	}
+}
+
+func (msg *MsgDenyAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDenyAddress) Type() string {
+	return TypeMsgDenyAddress
+}
+
+func (msg *MsgDenyAddress) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgDenyAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDenyAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDistribute = "distribute"
+
+var _ sdk.Msg = &MsgDistribute{}
+
+func NewMsgDistribute(distributor string, symbol string, denom string, amount string, policy DistributionPolicy) *MsgDistribute {
+	return &MsgDistribute{
+		Distributor: distributor,
+		Symbol:      symbol,
+		Denom:       denom,
+		Amount:      amount,
+		Policy:      policy,
+	}
+}
+
+func (msg *MsgDistribute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDistribute) Type() string {
+	return TypeMsgDistribute
+}
+
+func (msg *MsgDistribute) GetSigners() []sdk.AccAddress {
+	distributor, err := sdk.AccAddressFromBech32(msg.Distributor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{distributor}
+}
+
+func (msg *MsgDistribute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDistribute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Distributor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid distributor address: %s", err)
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid denom: %s", err)
+	}
+	if amount, ok := math.NewIntFromString(msg.Amount); !ok || !amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+	return validateDistributionPolicy(msg.Policy)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgForceTransfer = "force_transfer"
+
+var _ sdk.Msg = &MsgForceTransfer{}
+
+func NewMsgForceTransfer(manager string, symbol string, from string, to string, amount string, reason string) *MsgForceTransfer {
+	return &MsgForceTransfer{
+		Manager: manager,
+		Symbol:  symbol,
+		From:    from,
+		To:      to,
+		Amount:  amount,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgForceTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgForceTransfer) Type() string {
+	return TypeMsgForceTransfer
+}
+
+func (msg *MsgForceTransfer) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgForceTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgForceTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.To); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if amount, ok := math.NewIntFromString(msg.Amount); !ok || !amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+
+	if strings.TrimSpace(msg.Reason) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "reason cannot be empty")
+	}
+
+	return nil
+}
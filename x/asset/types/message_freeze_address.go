@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgFreezeAddress = "freeze_address"
+
+var _ sdk.Msg = &MsgFreezeAddress{}
+
+func NewMsgFreezeAddress(manager string, symbol string, address string) *MsgFreezeAddress {
+	return &MsgFreezeAddress{
+		Manager: manager,
+		Symbol:  symbol,
+		Address: address,
+	}
+}
+
+func (msg *MsgFreezeAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFreezeAddress) Type() string {
+	return TypeMsgFreezeAddress
+}
+
+func (msg *MsgFreezeAddress) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgFreezeAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFreezeAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgGrantRole = "grant_role"
+
+var _ sdk.Msg = &MsgGrantRole{}
+
+func NewMsgGrantRole(manager string, symbol string, address string, role Role) *MsgGrantRole {
+	return &MsgGrantRole{
+		Manager: manager,
+		Symbol:  symbol,
+		Address: address,
+		Role:    role,
+	}
+}
+
+func (msg *MsgGrantRole) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgGrantRole) Type() string {
+	return TypeMsgGrantRole
+}
+
+func (msg *MsgGrantRole) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgGrantRole) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgGrantRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	return ValidateRole(msg.Role)
+}
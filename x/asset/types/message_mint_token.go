@@ -0,0 +1,56 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgMintToken = "mint_token"
+
+var _ sdk.Msg = &MsgMintToken{}
+
+func NewMsgMintToken(manager string, symbol string, to string, amount string) *MsgMintToken {
+	return &MsgMintToken{
+		Manager: manager,
+		Symbol:  symbol,
+		To:      to,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgMintToken) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMintToken) Type() string {
+	return TypeMsgMintToken
+}
+
+func (msg *MsgMintToken) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgMintToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMintToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.To); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+	amount, ok := math.NewIntFromString(msg.Amount)
+	if !ok || !amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid mint amount %s", msg.Amount)
+	}
+
+	return nil
+}
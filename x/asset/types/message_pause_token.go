@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPauseToken = "pause_token"
+
+var _ sdk.Msg = &MsgPauseToken{}
+
+func NewMsgPauseToken(manager string, symbol string) *MsgPauseToken {
+	return &MsgPauseToken{
+		Manager: manager,
+		Symbol:  symbol,
+	}
+}
+
+func (msg *MsgPauseToken) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPauseToken) Type() string {
+	return TypeMsgPauseToken
+}
+
+func (msg *MsgPauseToken) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgPauseToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPauseToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgProposeManager = "propose_manager"
+
+var _ sdk.Msg = &MsgProposeManager{}
+
+func NewMsgProposeManager(manager string, symbol string, newManager string) *MsgProposeManager {
+	return &MsgProposeManager{
+		Manager:    manager,
+		Symbol:     symbol,
+		NewManager: newManager,
+	}
+}
+
+func (msg *MsgProposeManager) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgProposeManager) Type() string {
+	return TypeMsgProposeManager
+}
+
+func (msg *MsgProposeManager) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgProposeManager) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgProposeManager) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewManager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new manager address: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRedeemToken = "redeem_token"
+
+var _ sdk.Msg = &MsgRedeemToken{}
+
+func NewMsgRedeemToken(manager string, symbol string, holder string) *MsgRedeemToken {
+	return &MsgRedeemToken{
+		Manager: manager,
+		Symbol:  symbol,
+		Holder:  holder,
+	}
+}
+
+func (msg *MsgRedeemToken) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRedeemToken) Type() string {
+	return TypeMsgRedeemToken
+}
+
+func (msg *MsgRedeemToken) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgRedeemToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRedeemToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", err)
+	}
+
+	return nil
+}
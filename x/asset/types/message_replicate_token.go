@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+const TypeMsgReplicateToken = "replicate_token"
+
+var _ sdk.Msg = &MsgReplicateToken{}
+
+func NewMsgReplicateToken(manager string, symbol string, channel string, timeoutTimestamp uint64) *MsgReplicateToken {
+	return &MsgReplicateToken{
+		Manager:          manager,
+		Symbol:           symbol,
+		Channel:          channel,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgReplicateToken) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReplicateToken) Type() string {
+	return TypeMsgReplicateToken
+}
+
+func (msg *MsgReplicateToken) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgReplicateToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReplicateToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.Channel); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel: %s", err)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketTimeout, "timeout timestamp cannot be 0")
+	}
+	return nil
+}
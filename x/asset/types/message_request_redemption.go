@@ -0,0 +1,52 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRequestRedemption = "request_redemption"
+
+var _ sdk.Msg = &MsgRequestRedemption{}
+
+func NewMsgRequestRedemption(holder string, symbol string, amount string) *MsgRequestRedemption {
+	return &MsgRequestRedemption{
+		Holder: holder,
+		Symbol: symbol,
+		Amount: amount,
+	}
+}
+
+func (msg *MsgRequestRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRequestRedemption) Type() string {
+	return TypeMsgRequestRedemption
+}
+
+func (msg *MsgRequestRedemption) GetSigners() []sdk.AccAddress {
+	holder, err := sdk.AccAddressFromBech32(msg.Holder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{holder}
+}
+
+func (msg *MsgRequestRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRequestRedemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Holder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid holder address: %s", err)
+	}
+	amount, ok := math.NewIntFromString(msg.Amount)
+	if !ok || !amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid redemption amount %s", msg.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRevokeRole = "revoke_role"
+
+var _ sdk.Msg = &MsgRevokeRole{}
+
+func NewMsgRevokeRole(manager string, symbol string, address string, role Role) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Manager: manager,
+		Symbol:  symbol,
+		Address: address,
+		Role:    role,
+	}
+}
+
+func (msg *MsgRevokeRole) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRevokeRole) Type() string {
+	return TypeMsgRevokeRole
+}
+
+func (msg *MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgRevokeRole) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRevokeRole) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	return ValidateRole(msg.Role)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+const TypeMsgSendToken = "send_token"
+
+var _ sdk.Msg = &MsgSendToken{}
+
+func NewMsgSendToken(sender string, symbol string, amount string, receiver string, channel string, timeoutTimestamp uint64) *MsgSendToken {
+	return &MsgSendToken{
+		Sender:           sender,
+		Symbol:           symbol,
+		Amount:           amount,
+		Receiver:         receiver,
+		Channel:          channel,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+func (msg *MsgSendToken) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSendToken) Type() string {
+	return TypeMsgSendToken
+}
+
+func (msg *MsgSendToken) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgSendToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSendToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid receiver address: %s", err)
+	}
+	if amount, ok := math.NewIntFromString(msg.Amount); !ok || !amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+	if err := host.ChannelIdentifierValidator(msg.Channel); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel: %s", err)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketTimeout, "timeout timestamp cannot be 0")
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetBlackoutSchedule = "set_blackout_schedule"
+
+var _ sdk.Msg = &MsgSetBlackoutSchedule{}
+
+func NewMsgSetBlackoutSchedule(manager string, symbol string, windows []BlackoutWindow) *MsgSetBlackoutSchedule {
+	return &MsgSetBlackoutSchedule{
+		Manager: manager,
+		Symbol:  symbol,
+		Windows: windows,
+	}
+}
+
+func (msg *MsgSetBlackoutSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBlackoutSchedule) Type() string {
+	return TypeMsgSetBlackoutSchedule
+}
+
+func (msg *MsgSetBlackoutSchedule) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgSetBlackoutSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBlackoutSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	return validateBlackoutWindows(msg.Windows)
+}
+
+// validateBlackoutWindows checks that a schedule is bounded and that every
+// window ends after it starts.
+func validateBlackoutWindows(windows []BlackoutWindow) error {
+	if len(windows) > MaxBlackoutWindows {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%d blackout windows exceeds %d", len(windows), MaxBlackoutWindows)
+	}
+	for _, window := range windows {
+		if !window.End.After(window.Start) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "blackout window ending %s must end after its start %s", window.End, window.Start)
+		}
+	}
+	return nil
+}
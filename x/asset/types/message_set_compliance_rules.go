@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetComplianceRules = "set_compliance_rules"
+
+var _ sdk.Msg = &MsgSetComplianceRules{}
+
+func NewMsgSetComplianceRules(manager string, symbol string, rules []ComplianceRuleConfig) *MsgSetComplianceRules {
+	return &MsgSetComplianceRules{
+		Manager: manager,
+		Symbol:  symbol,
+		Rules:   rules,
+	}
+}
+
+func (msg *MsgSetComplianceRules) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetComplianceRules) Type() string {
+	return TypeMsgSetComplianceRules
+}
+
+func (msg *MsgSetComplianceRules) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgSetComplianceRules) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic checks the shape of the rule list, the rules themselves are
+// only known to the keeper and validate their params there.
+func (msg *MsgSetComplianceRules) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if len(msg.Rules) > MaxComplianceRules {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%d compliance rules exceeds %d", len(msg.Rules), MaxComplianceRules)
+	}
+	seen := make(map[string]bool, len(msg.Rules))
+	for _, rule := range msg.Rules {
+		if rule.Id == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "compliance rule id cannot be blank")
+		}
+		if IsMandatoryComplianceRule(rule.Id) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "compliance rule %s is always checked", rule.Id)
+		}
+		if seen[rule.Id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate compliance rule %s", rule.Id)
+		}
+		seen[rule.Id] = true
+	}
+	return nil
+}
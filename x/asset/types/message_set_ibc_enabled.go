@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetIBCEnabled = "set_ibc_enabled"
+
+var _ sdk.Msg = &MsgSetIBCEnabled{}
+
+func NewMsgSetIBCEnabled(manager string, symbol string, enabled bool) *MsgSetIBCEnabled {
+	return &MsgSetIBCEnabled{
+		Manager: manager,
+		Symbol:  symbol,
+		Enabled: enabled,
+	}
+}
+
+func (msg *MsgSetIBCEnabled) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetIBCEnabled) Type() string {
+	return TypeMsgSetIBCEnabled
+}
+
+func (msg *MsgSetIBCEnabled) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgSetIBCEnabled) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetIBCEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	return nil
+}
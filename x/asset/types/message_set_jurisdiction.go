@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetJurisdiction = "set_jurisdiction"
+
+var _ sdk.Msg = &MsgSetJurisdiction{}
+
+func NewMsgSetJurisdiction(manager string, symbol string, address string, jurisdiction string) *MsgSetJurisdiction {
+	return &MsgSetJurisdiction{
+		Manager:      manager,
+		Symbol:       symbol,
+		Address:      address,
+		Jurisdiction: jurisdiction,
+	}
+}
+
+func (msg *MsgSetJurisdiction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetJurisdiction) Type() string {
+	return TypeMsgSetJurisdiction
+}
+
+func (msg *MsgSetJurisdiction) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgSetJurisdiction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetJurisdiction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	if strings.ContainsAny(msg.Jurisdiction, ", ") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid jurisdiction %q", msg.Jurisdiction)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSetRestrictionMode = "set_restriction_mode"
+
+var _ sdk.Msg = &MsgSetRestrictionMode{}
+
+func NewMsgSetRestrictionMode(manager string, symbol string, mode RestrictionMode) *MsgSetRestrictionMode {
+	return &MsgSetRestrictionMode{
+		Manager: manager,
+		Symbol:  symbol,
+		Mode:    mode,
+	}
+}
+
+func (msg *MsgSetRestrictionMode) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRestrictionMode) Type() string {
+	return TypeMsgSetRestrictionMode
+}
+
+func (msg *MsgSetRestrictionMode) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgSetRestrictionMode) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRestrictionMode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	return validateRestrictionMode(msg.Mode)
+}
+
+// validateRestrictionMode checks that a mode is one of the known restriction modes.
+func validateRestrictionMode(mode RestrictionMode) error {
+	if _, ok := RestrictionMode_name[int32(mode)]; !ok || mode == RestrictionModeUnspecified {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid restriction mode %s", mode)
+	}
+	return nil
+}
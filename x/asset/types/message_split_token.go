@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSplitToken = "split_token"
+
+var _ sdk.Msg = &MsgSplitToken{}
+
+func NewMsgSplitToken(manager string, symbol string, numerator uint64, denominator uint64) *MsgSplitToken {
+	return &MsgSplitToken{
+		Manager:     manager,
+		Symbol:      symbol,
+		Numerator:   numerator,
+		Denominator: denominator,
+	}
+}
+
+func (msg *MsgSplitToken) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSplitToken) Type() string {
+	return TypeMsgSplitToken
+}
+
+func (msg *MsgSplitToken) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgSplitToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSplitToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if msg.Numerator == 0 || msg.Denominator == 0 || msg.Numerator == msg.Denominator {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid split ratio %d/%d", msg.Numerator, msg.Denominator)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/suite"
@@ -62,16 +63,45 @@ func (suite *MessageTestSuite) TestMsgCreateToken_ValidateBasic() {
 			name: "valid address",
 			msg: MsgCreateToken{
 				Manager: testutil.GenAddress().String(),
+				Symbol:  "RST",
 			},
+		}, {
+			name: "too many decimals",
+			msg: MsgCreateToken{
+				Manager:  testutil.GenAddress().String(),
+				Symbol:   "RST",
+				Decimals: "19",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty symbol",
+			msg: MsgCreateToken{
+				Manager: testutil.GenAddress().String(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "symbol with separator",
+			msg: MsgCreateToken{
+				Manager: testutil.GenAddress().String(),
+				Symbol:  "rst/usd",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "symbol starting with a digit",
+			msg: MsgCreateToken{
+				Manager: testutil.GenAddress().String(),
+				Symbol:  "1rst",
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
 		err := tt.msg.ValidateBasic()
 		if tt.err != nil {
-			suite.Require().ErrorIs(err, tt.err)
-			return
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
 		}
-		suite.Require().NoError(err)
+		suite.Require().NoError(err, tt.name)
 	}
 }
 
@@ -162,3 +192,799 @@ func (suite *MessageTestSuite) TestMsgUpdateToken_ValidateBasic() {
 		suite.Require().NoError(err)
 	}
 }
+
+func (suite *MessageTestSuite) TestMsgMintToken_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgMintToken
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgMintToken{
+				Manager: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid amount",
+			msg: MsgMintToken{
+				Manager: testutil.GenAddress().String(),
+				To:      testutil.GenAddress().String(),
+				Amount:  "-1",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid message",
+			msg: MsgMintToken{
+				Manager: testutil.GenAddress().String(),
+				To:      testutil.GenAddress().String(),
+				Amount:  "100",
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgBurnToken_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgBurnToken
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBurnToken{
+				Holder: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid amount",
+			msg: MsgBurnToken{
+				Holder: testutil.GenAddress().String(),
+				Amount: "0",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid message",
+			msg: MsgBurnToken{
+				Holder: testutil.GenAddress().String(),
+				Amount: "100",
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgGrantRole_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgGrantRole
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgGrantRole{
+				Manager: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "unspecified role",
+			msg: MsgGrantRole{
+				Manager: testutil.GenAddress().String(),
+				Address: testutil.GenAddress().String(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "unknown role",
+			msg: MsgGrantRole{
+				Manager: testutil.GenAddress().String(),
+				Address: testutil.GenAddress().String(),
+				Role:    Role(42),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgGrantRole{
+				Manager: testutil.GenAddress().String(),
+				Address: testutil.GenAddress().String(),
+				Role:    RoleIssuer,
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgForceTransfer_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgForceTransfer
+		err  error
+	}{
+		{
+			name: "invalid from address",
+			msg: MsgForceTransfer{
+				Manager: testutil.GenAddress().String(),
+				From:    "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing reason",
+			msg: MsgForceTransfer{
+				Manager: testutil.GenAddress().String(),
+				From:    testutil.GenAddress().String(),
+				To:      testutil.GenAddress().String(),
+				Amount:  "100",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgForceTransfer{
+				Manager: testutil.GenAddress().String(),
+				From:    testutil.GenAddress().String(),
+				To:      testutil.GenAddress().String(),
+				Amount:  "100",
+				Reason:  "court order 42",
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgBatchAuthorize_ValidateBasic() {
+	address := testutil.GenAddress().String()
+	tests := []struct {
+		name string
+		msg  MsgBatchAuthorize
+		err  error
+	}{
+		{
+			name: "empty batch",
+			msg: MsgBatchAuthorize{
+				Manager: testutil.GenAddress().String(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid address in batch",
+			msg: MsgBatchAuthorize{
+				Manager:   testutil.GenAddress().String(),
+				Addresses: []string{address, "invalid_address"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicate address in batch",
+			msg: MsgBatchAuthorize{
+				Manager:   testutil.GenAddress().String(),
+				Addresses: []string{address, address},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgBatchAuthorize{
+				Manager:   testutil.GenAddress().String(),
+				Addresses: []string{address, testutil.GenAddress().String()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgUpdateHolderLimits_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgUpdateHolderLimits
+		err  error
+	}{
+		{
+			name: "invalid manager",
+			msg: MsgUpdateHolderLimits{
+				Manager: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero max balance",
+			msg: MsgUpdateHolderLimits{
+				Manager:             testutil.GenAddress().String(),
+				MaxBalancePerHolder: "0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid max balance",
+			msg: MsgUpdateHolderLimits{
+				Manager:             testutil.GenAddress().String(),
+				MaxBalancePerHolder: "ten",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgUpdateHolderLimits{
+				Manager:             testutil.GenAddress().String(),
+				MaxHolders:          99,
+				MaxBalancePerHolder: "1000",
+			},
+		}, {
+			name: "limits removed",
+			msg: MsgUpdateHolderLimits{
+				Manager: testutil.GenAddress().String(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgSetBlackoutSchedule_ValidateBasic() {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		msg  MsgSetBlackoutSchedule
+		err  error
+	}{
+		{
+			name: "invalid manager",
+			msg: MsgSetBlackoutSchedule{
+				Manager: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "window ending at its start",
+			msg: MsgSetBlackoutSchedule{
+				Manager: testutil.GenAddress().String(),
+				Windows: []BlackoutWindow{{Start: start, End: start}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many windows",
+			msg: MsgSetBlackoutSchedule{
+				Manager: testutil.GenAddress().String(),
+				Windows: make([]BlackoutWindow, MaxBlackoutWindows+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgSetBlackoutSchedule{
+				Manager: testutil.GenAddress().String(),
+				Windows: []BlackoutWindow{{Start: start, End: start.Add(time.Hour)}},
+			},
+		}, {
+			name: "schedule cleared",
+			msg: MsgSetBlackoutSchedule{
+				Manager: testutil.GenAddress().String(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgSetComplianceRules_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgSetComplianceRules
+		err  error
+	}{
+		{
+			name: "invalid manager",
+			msg: MsgSetComplianceRules{
+				Manager: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "blank rule id",
+			msg: MsgSetComplianceRules{
+				Manager: testutil.GenAddress().String(),
+				Rules:   []ComplianceRuleConfig{{Params: "US"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate rule",
+			msg: MsgSetComplianceRules{
+				Manager: testutil.GenAddress().String(),
+				Rules:   []ComplianceRuleConfig{{Id: RuleIDJurisdiction, Params: "US"}, {Id: RuleIDJurisdiction, Params: "CA"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "mandatory rule",
+			msg: MsgSetComplianceRules{
+				Manager: testutil.GenAddress().String(),
+				Rules:   []ComplianceRuleConfig{{Id: RuleIDFreeze}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgSetComplianceRules{
+				Manager: testutil.GenAddress().String(),
+				Rules:   []ComplianceRuleConfig{{Id: RuleIDJurisdiction, Params: "US,CA"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgSetRestrictionMode_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgSetRestrictionMode
+		err  error
+	}{
+		{
+			name: "invalid manager",
+			msg: MsgSetRestrictionMode{
+				Manager: "invalid_address",
+				Mode:    RestrictionModeDenylist,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "unspecified mode",
+			msg: MsgSetRestrictionMode{
+				Manager: testutil.GenAddress().String(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "unknown mode",
+			msg: MsgSetRestrictionMode{
+				Manager: testutil.GenAddress().String(),
+				Mode:    RestrictionMode(42),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgSetRestrictionMode{
+				Manager: testutil.GenAddress().String(),
+				Mode:    RestrictionModeDenylist,
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgReplicateToken_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgReplicateToken
+		err  error
+	}{
+		{
+			name: "invalid manager",
+			msg: MsgReplicateToken{
+				Manager:          "invalid_address",
+				Channel:          "channel-0",
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid channel",
+			msg: MsgReplicateToken{
+				Manager:          testutil.GenAddress().String(),
+				Channel:          "0",
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "no timeout",
+			msg: MsgReplicateToken{
+				Manager: testutil.GenAddress().String(),
+				Channel: "channel-0",
+			},
+			err: ErrInvalidPacketTimeout,
+		}, {
+			name: "valid message",
+			msg: MsgReplicateToken{
+				Manager:          testutil.GenAddress().String(),
+				Channel:          "channel-0",
+				TimeoutTimestamp: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgSendToken_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgSendToken
+		err  error
+	}{
+		{
+			name: "invalid sender",
+			msg: MsgSendToken{
+				Sender:           "invalid_address",
+				Receiver:         testutil.GenAddress().String(),
+				Amount:           "10",
+				Channel:          "channel-0",
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid receiver",
+			msg: MsgSendToken{
+				Sender:           testutil.GenAddress().String(),
+				Receiver:         "invalid_address",
+				Amount:           "10",
+				Channel:          "channel-0",
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgSendToken{
+				Sender:           testutil.GenAddress().String(),
+				Receiver:         testutil.GenAddress().String(),
+				Amount:           "0",
+				Channel:          "channel-0",
+				TimeoutTimestamp: 1,
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "no timeout",
+			msg: MsgSendToken{
+				Sender:   testutil.GenAddress().String(),
+				Receiver: testutil.GenAddress().String(),
+				Amount:   "10",
+				Channel:  "channel-0",
+			},
+			err: ErrInvalidPacketTimeout,
+		}, {
+			name: "valid message",
+			msg: MsgSendToken{
+				Sender:           testutil.GenAddress().String(),
+				Receiver:         testutil.GenAddress().String(),
+				Amount:           "10",
+				Channel:          "channel-0",
+				TimeoutTimestamp: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgDistribute_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgDistribute
+		err  error
+	}{
+		{
+			name: "invalid distributor",
+			msg: MsgDistribute{
+				Distributor: "invalid_address",
+				Symbol:      "RST",
+				Denom:       "ario",
+				Amount:      "10",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid denom",
+			msg: MsgDistribute{
+				Distributor: testutil.GenAddress().String(),
+				Symbol:      "RST",
+				Denom:       "1",
+				Amount:      "10",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "zero amount",
+			msg: MsgDistribute{
+				Distributor: testutil.GenAddress().String(),
+				Symbol:      "RST",
+				Denom:       "ario",
+				Amount:      "0",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "unknown policy",
+			msg: MsgDistribute{
+				Distributor: testutil.GenAddress().String(),
+				Symbol:      "RST",
+				Denom:       "ario",
+				Amount:      "10",
+				Policy:      DistributionPolicy(7),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgDistribute{
+				Distributor: testutil.GenAddress().String(),
+				Symbol:      "RST",
+				Denom:       "ario",
+				Amount:      "10",
+				Policy:      DistributionPolicyForfeit,
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgClaimDistribution_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgClaimDistribution
+		err  error
+	}{
+		{
+			name: "invalid holder",
+			msg: MsgClaimDistribution{
+				Holder: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgClaimDistribution{
+				Holder:         testutil.GenAddress().String(),
+				DistributionId: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgCreateSnapshot_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgCreateSnapshot
+		err  error
+	}{
+		{
+			name: "invalid manager",
+			msg: MsgCreateSnapshot{
+				Manager: "invalid_address",
+				Symbol:  "RST",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgCreateSnapshot{
+				Manager: testutil.GenAddress().String(),
+				Symbol:  "RST",
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgSplitToken_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgSplitToken
+		err  error
+	}{
+		{
+			name: "invalid manager",
+			msg: MsgSplitToken{
+				Manager:     "invalid_address",
+				Symbol:      "RST",
+				Numerator:   2,
+				Denominator: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero denominator",
+			msg: MsgSplitToken{
+				Manager:   testutil.GenAddress().String(),
+				Symbol:    "RST",
+				Numerator: 2,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "unit ratio",
+			msg: MsgSplitToken{
+				Manager:     testutil.GenAddress().String(),
+				Symbol:      "RST",
+				Numerator:   3,
+				Denominator: 3,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgSplitToken{
+				Manager:     testutil.GenAddress().String(),
+				Symbol:      "RST",
+				Numerator:   1,
+				Denominator: 10,
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgCreateSettlement_ValidateBasic() {
+	rst := SettlementLeg{Symbol: "RST", Amount: "100"}
+	btf := SettlementLeg{Symbol: "BTF", Amount: "250"}
+	expiry := time.Now()
+	tests := []struct {
+		name string
+		msg  MsgCreateSettlement
+		err  error
+	}{
+		{
+			name: "invalid counterparty",
+			msg: MsgCreateSettlement{
+				Creator:         testutil.GenAddress().String(),
+				Counterparty:    "invalid_address",
+				CreatorLeg:      rst,
+				CounterpartyLeg: btf,
+				Expiry:          expiry,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "non positive leg",
+			msg: MsgCreateSettlement{
+				Creator:         testutil.GenAddress().String(),
+				Counterparty:    testutil.GenAddress().String(),
+				CreatorLeg:      rst,
+				CounterpartyLeg: SettlementLeg{Symbol: "BTF", Amount: "0"},
+				Expiry:          expiry,
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "same token on both legs",
+			msg: MsgCreateSettlement{
+				Creator:         testutil.GenAddress().String(),
+				Counterparty:    testutil.GenAddress().String(),
+				CreatorLeg:      rst,
+				CounterpartyLeg: SettlementLeg{Symbol: "rst", Amount: "1"},
+				Expiry:          expiry,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing expiry",
+			msg: MsgCreateSettlement{
+				Creator:         testutil.GenAddress().String(),
+				Counterparty:    testutil.GenAddress().String(),
+				CreatorLeg:      rst,
+				CounterpartyLeg: btf,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid message",
+			msg: MsgCreateSettlement{
+				Creator:         testutil.GenAddress().String(),
+				Counterparty:    testutil.GenAddress().String(),
+				CreatorLeg:      rst,
+				CounterpartyLeg: btf,
+				Expiry:          expiry,
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
+
+func (suite *MessageTestSuite) TestMsgAffirmSettlement_ValidateBasic() {
+	tests := []struct {
+		name string
+		msg  MsgAffirmSettlement
+		err  error
+	}{
+		{
+			name: "invalid counterparty",
+			msg: MsgAffirmSettlement{
+				Counterparty: "invalid_address",
+				Id:           1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgAffirmSettlement{
+				Counterparty: testutil.GenAddress().String(),
+				Id:           1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		err := tt.msg.ValidateBasic()
+		if tt.err != nil {
+			suite.Require().ErrorIs(err, tt.err, tt.name)
+			continue
+		}
+		suite.Require().NoError(err, tt.name)
+	}
+}
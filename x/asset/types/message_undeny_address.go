@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUndenyAddress = "undeny_address"
+
+var _ sdk.Msg = &MsgUndenyAddress{}
+
+func NewMsgUndenyAddress(manager string, symbol string, address string) *MsgUndenyAddress {
+	return &MsgUndenyAddress{
+		Manager: manager,
+		Symbol:  symbol,
+		Address: address,
+	}
+}
+
+func (msg *MsgUndenyAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUndenyAddress) Type() string {
+	return TypeMsgUndenyAddress
+}
+
+func (msg *MsgUndenyAddress) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgUndenyAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUndenyAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUnfreezeAddress = "unfreeze_address"
+
+var _ sdk.Msg = &MsgUnfreezeAddress{}
+
+func NewMsgUnfreezeAddress(manager string, symbol string, address string) *MsgUnfreezeAddress {
+	return &MsgUnfreezeAddress{
+		Manager: manager,
+		Symbol:  symbol,
+		Address: address,
+	}
+}
+
+func (msg *MsgUnfreezeAddress) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnfreezeAddress) Type() string {
+	return TypeMsgUnfreezeAddress
+}
+
+func (msg *MsgUnfreezeAddress) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgUnfreezeAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnfreezeAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUnpauseToken = "unpause_token"
+
+var _ sdk.Msg = &MsgUnpauseToken{}
+
+func NewMsgUnpauseToken(manager string, symbol string) *MsgUnpauseToken {
+	return &MsgUnpauseToken{
+		Manager: manager,
+		Symbol:  symbol,
+	}
+}
+
+func (msg *MsgUnpauseToken) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnpauseToken) Type() string {
+	return TypeMsgUnpauseToken
+}
+
+func (msg *MsgUnpauseToken) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgUnpauseToken) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnpauseToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	return nil
+}
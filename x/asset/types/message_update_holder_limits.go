@@ -0,0 +1,60 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateHolderLimits = "update_holder_limits"
+
+var _ sdk.Msg = &MsgUpdateHolderLimits{}
+
+func NewMsgUpdateHolderLimits(manager string, symbol string, maxHolders uint64, maxBalancePerHolder string) *MsgUpdateHolderLimits {
+	return &MsgUpdateHolderLimits{
+		Manager:             manager,
+		Symbol:              symbol,
+		MaxHolders:          maxHolders,
+		MaxBalancePerHolder: maxBalancePerHolder,
+	}
+}
+
+func (msg *MsgUpdateHolderLimits) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateHolderLimits) Type() string {
+	return TypeMsgUpdateHolderLimits
+}
+
+func (msg *MsgUpdateHolderLimits) GetSigners() []sdk.AccAddress {
+	manager, err := sdk.AccAddressFromBech32(msg.Manager)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{manager}
+}
+
+func (msg *MsgUpdateHolderLimits) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateHolderLimits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Manager); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid manager address: %s", err)
+	}
+	return validateMaxBalancePerHolder(msg.MaxBalancePerHolder)
+}
+
+// validateMaxBalancePerHolder checks that an optional balance cap is a positive
+// amount of whole units.
+func validateMaxBalancePerHolder(maxBalancePerHolder string) error {
+	if maxBalancePerHolder == "" {
+		return nil
+	}
+	if maxBalance, ok := math.NewIntFromString(maxBalancePerHolder); !ok || !maxBalance.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid max balance per holder %s", maxBalancePerHolder)
+	}
+	return nil
+}
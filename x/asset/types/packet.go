@@ -0,0 +1,14 @@
+package types
+
+// GetBytes is a helper for serialising
+func (p AssetPacketData) GetBytes() ([]byte, error) {
+	return ModuleCdc.MarshalJSON(&p)
+}
+
+func NewChannelEscrow(channel string, symbol string, amount string) ChannelEscrow {
+	return ChannelEscrow{
+		Channel: channel,
+		Symbol:  symbol,
+		Amount:  amount,
+	}
+}
@@ -0,0 +1,2083 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realionetwork/asset/v1/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AssetPacketData is the packet data of the asset ibc application
+type AssetPacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*AssetPacketData_NoData
+	//	*AssetPacketData_TokenDefinition
+	//	*AssetPacketData_TokenTransfer
+	Packet isAssetPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *AssetPacketData) Reset()         { *m = AssetPacketData{} }
+func (m *AssetPacketData) String() string { return proto.CompactTextString(m) }
+func (*AssetPacketData) ProtoMessage()    {}
+func (*AssetPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_140a4050cd84e24a, []int{0}
+}
+func (m *AssetPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetPacketData.Merge(m, src)
+}
+func (m *AssetPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetPacketData proto.InternalMessageInfo
+
+type isAssetPacketData_Packet interface {
+	isAssetPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type AssetPacketData_NoData struct {
+	NoData *NoData `protobuf:"bytes,1,opt,name=noData,proto3,oneof" json:"noData,omitempty"`
+}
+type AssetPacketData_TokenDefinition struct {
+	TokenDefinition *TokenDefinitionPacketData `protobuf:"bytes,2,opt,name=tokenDefinition,proto3,oneof" json:"tokenDefinition,omitempty"`
+}
+type AssetPacketData_TokenTransfer struct {
+	TokenTransfer *TokenTransferPacketData `protobuf:"bytes,3,opt,name=tokenTransfer,proto3,oneof" json:"tokenTransfer,omitempty"`
+}
+
+func (*AssetPacketData_NoData) isAssetPacketData_Packet()          {}
+func (*AssetPacketData_TokenDefinition) isAssetPacketData_Packet() {}
+func (*AssetPacketData_TokenTransfer) isAssetPacketData_Packet()   {}
+
+func (m *AssetPacketData) GetPacket() isAssetPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *AssetPacketData) GetNoData() *NoData {
+	if x, ok := m.GetPacket().(*AssetPacketData_NoData); ok {
+		return x.NoData
+	}
+	return nil
+}
+
+func (m *AssetPacketData) GetTokenDefinition() *TokenDefinitionPacketData {
+	if x, ok := m.GetPacket().(*AssetPacketData_TokenDefinition); ok {
+		return x.TokenDefinition
+	}
+	return nil
+}
+
+func (m *AssetPacketData) GetTokenTransfer() *TokenTransferPacketData {
+	if x, ok := m.GetPacket().(*AssetPacketData_TokenTransfer); ok {
+		return x.TokenTransfer
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AssetPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AssetPacketData_NoData)(nil),
+		(*AssetPacketData_TokenDefinition)(nil),
+		(*AssetPacketData_TokenTransfer)(nil),
+	}
+}
+
+type NoData struct {
+}
+
+func (m *NoData) Reset()         { *m = NoData{} }
+func (m *NoData) String() string { return proto.CompactTextString(m) }
+func (*NoData) ProtoMessage()    {}
+func (*NoData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_140a4050cd84e24a, []int{1}
+}
+func (m *NoData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NoData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NoData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoData.Merge(m, src)
+}
+func (m *NoData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoData proto.InternalMessageInfo
+
+// ChannelEscrow is the amount of a token, in base units, escrowed while it is
+// on the other end of an asset channel
+type ChannelEscrow struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Symbol  string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *ChannelEscrow) Reset()         { *m = ChannelEscrow{} }
+func (m *ChannelEscrow) String() string { return proto.CompactTextString(m) }
+func (*ChannelEscrow) ProtoMessage()    {}
+func (*ChannelEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_140a4050cd84e24a, []int{2}
+}
+func (m *ChannelEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEscrow.Merge(m, src)
+}
+func (m *ChannelEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEscrow proto.InternalMessageInfo
+
+func (m *ChannelEscrow) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelEscrow) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ChannelEscrow) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// TokenDefinitionPacketData replicates a token and its compliance data to the
+// counterparty chain
+type TokenDefinitionPacketData struct {
+	Token          Token                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	Authorizations []TokenAuthorization  `protobuf:"bytes,2,rep,name=authorizations,proto3" json:"authorizations"`
+	Frozen         []FrozenAddress       `protobuf:"bytes,3,rep,name=frozen,proto3" json:"frozen"`
+	Denied         []DeniedAddress       `protobuf:"bytes,4,rep,name=denied,proto3" json:"denied"`
+	Jurisdictions  []AddressJurisdiction `protobuf:"bytes,5,rep,name=jurisdictions,proto3" json:"jurisdictions"`
+}
+
+func (m *TokenDefinitionPacketData) Reset()         { *m = TokenDefinitionPacketData{} }
+func (m *TokenDefinitionPacketData) String() string { return proto.CompactTextString(m) }
+func (*TokenDefinitionPacketData) ProtoMessage()    {}
+func (*TokenDefinitionPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_140a4050cd84e24a, []int{3}
+}
+func (m *TokenDefinitionPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenDefinitionPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenDefinitionPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenDefinitionPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenDefinitionPacketData.Merge(m, src)
+}
+func (m *TokenDefinitionPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenDefinitionPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenDefinitionPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenDefinitionPacketData proto.InternalMessageInfo
+
+func (m *TokenDefinitionPacketData) GetToken() Token {
+	if m != nil {
+		return m.Token
+	}
+	return Token{}
+}
+
+func (m *TokenDefinitionPacketData) GetAuthorizations() []TokenAuthorization {
+	if m != nil {
+		return m.Authorizations
+	}
+	return nil
+}
+
+func (m *TokenDefinitionPacketData) GetFrozen() []FrozenAddress {
+	if m != nil {
+		return m.Frozen
+	}
+	return nil
+}
+
+func (m *TokenDefinitionPacketData) GetDenied() []DeniedAddress {
+	if m != nil {
+		return m.Denied
+	}
+	return nil
+}
+
+func (m *TokenDefinitionPacketData) GetJurisdictions() []AddressJurisdiction {
+	if m != nil {
+		return m.Jurisdictions
+	}
+	return nil
+}
+
+// TokenDefinitionPacketAck defines a struct for the token definition packet
+// acknowledgment
+type TokenDefinitionPacketAck struct {
+}
+
+func (m *TokenDefinitionPacketAck) Reset()         { *m = TokenDefinitionPacketAck{} }
+func (m *TokenDefinitionPacketAck) String() string { return proto.CompactTextString(m) }
+func (*TokenDefinitionPacketAck) ProtoMessage()    {}
+func (*TokenDefinitionPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_140a4050cd84e24a, []int{4}
+}
+func (m *TokenDefinitionPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenDefinitionPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenDefinitionPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenDefinitionPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenDefinitionPacketAck.Merge(m, src)
+}
+func (m *TokenDefinitionPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenDefinitionPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenDefinitionPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenDefinitionPacketAck proto.InternalMessageInfo
+
+// TokenTransferPacketData moves units of a token to the counterparty chain,
+// along with the compliance data of the receiver on the sending chain
+type TokenTransferPacketData struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// amount in base units
+	Amount               string          `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sender               string          `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver             string          `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	RestrictionMode      RestrictionMode `protobuf:"varint,5,opt,name=restrictionMode,proto3,enum=realionetwork.asset.v1.RestrictionMode" json:"restrictionMode,omitempty"`
+	ReceiverAuthorized   bool            `protobuf:"varint,6,opt,name=receiverAuthorized,proto3" json:"receiverAuthorized,omitempty"`
+	ReceiverJurisdiction string          `protobuf:"bytes,7,opt,name=receiverJurisdiction,proto3" json:"receiverJurisdiction,omitempty"`
+}
+
+func (m *TokenTransferPacketData) Reset()         { *m = TokenTransferPacketData{} }
+func (m *TokenTransferPacketData) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferPacketData) ProtoMessage()    {}
+func (*TokenTransferPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_140a4050cd84e24a, []int{5}
+}
+func (m *TokenTransferPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenTransferPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenTransferPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenTransferPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferPacketData.Merge(m, src)
+}
+func (m *TokenTransferPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenTransferPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferPacketData proto.InternalMessageInfo
+
+func (m *TokenTransferPacketData) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenTransferPacketData) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *TokenTransferPacketData) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *TokenTransferPacketData) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TokenTransferPacketData) GetRestrictionMode() RestrictionMode {
+	if m != nil {
+		return m.RestrictionMode
+	}
+	return RestrictionModeUnspecified
+}
+
+func (m *TokenTransferPacketData) GetReceiverAuthorized() bool {
+	if m != nil {
+		return m.ReceiverAuthorized
+	}
+	return false
+}
+
+func (m *TokenTransferPacketData) GetReceiverJurisdiction() string {
+	if m != nil {
+		return m.ReceiverJurisdiction
+	}
+	return ""
+}
+
+// TokenTransferPacketAck defines a struct for the token transfer packet
+// acknowledgment
+type TokenTransferPacketAck struct {
+}
+
+func (m *TokenTransferPacketAck) Reset()         { *m = TokenTransferPacketAck{} }
+func (m *TokenTransferPacketAck) String() string { return proto.CompactTextString(m) }
+func (*TokenTransferPacketAck) ProtoMessage()    {}
+func (*TokenTransferPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_140a4050cd84e24a, []int{6}
+}
+func (m *TokenTransferPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenTransferPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenTransferPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenTransferPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransferPacketAck.Merge(m, src)
+}
+func (m *TokenTransferPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenTransferPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransferPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransferPacketAck proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AssetPacketData)(nil), "realionetwork.asset.v1.AssetPacketData")
+	proto.RegisterType((*NoData)(nil), "realionetwork.asset.v1.NoData")
+	proto.RegisterType((*ChannelEscrow)(nil), "realionetwork.asset.v1.ChannelEscrow")
+	proto.RegisterType((*TokenDefinitionPacketData)(nil), "realionetwork.asset.v1.TokenDefinitionPacketData")
+	proto.RegisterType((*TokenDefinitionPacketAck)(nil), "realionetwork.asset.v1.TokenDefinitionPacketAck")
+	proto.RegisterType((*TokenTransferPacketData)(nil), "realionetwork.asset.v1.TokenTransferPacketData")
+	proto.RegisterType((*TokenTransferPacketAck)(nil), "realionetwork.asset.v1.TokenTransferPacketAck")
+}
+
+func init() {
+	proto.RegisterFile("realionetwork/asset/v1/packet.proto", fileDescriptor_140a4050cd84e24a)
+}
+
+var fileDescriptor_140a4050cd84e24a = []byte{
+	// 607 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc7, 0x93, 0x76, 0xcb, 0x5a, 0x4f, 0xdb, 0x24, 0x6b, 0xda, 0xcf, 0xbf, 0x4a, 0x84, 0x2a,
+	0x68, 0xa2, 0x80, 0x48, 0xb4, 0x72, 0x03, 0x97, 0xdd, 0x06, 0x9a, 0x10, 0x20, 0x88, 0x26, 0xf1,
+	0x47, 0xe2, 0x22, 0x4b, 0x4e, 0xd7, 0xd0, 0xd6, 0xae, 0x6c, 0xb7, 0xa3, 0x7d, 0x07, 0x24, 0x24,
+	0x5e, 0x85, 0x87, 0xd8, 0xe5, 0x2e, 0xb9, 0x42, 0xa8, 0x7d, 0x11, 0x14, 0xc7, 0x61, 0x49, 0xd5,
+	0xf4, 0xce, 0xc7, 0xfe, 0x9e, 0x8f, 0xcf, 0x3f, 0x1d, 0x74, 0x8f, 0x43, 0x30, 0x88, 0x19, 0x05,
+	0x79, 0xc5, 0x78, 0xdf, 0x0b, 0x84, 0x00, 0xe9, 0x4d, 0x8e, 0xbc, 0x51, 0x10, 0xf6, 0x41, 0xba,
+	0x23, 0xce, 0x24, 0xc3, 0x07, 0x05, 0x91, 0xab, 0x44, 0xee, 0xe4, 0xa8, 0xb1, 0x7f, 0xc9, 0x2e,
+	0x99, 0x92, 0x78, 0xc9, 0x29, 0x55, 0x37, 0x9c, 0x12, 0xa4, 0x64, 0x7d, 0xa0, 0x5a, 0xe3, 0xad,
+	0xd3, 0x04, 0x63, 0xd9, 0x63, 0x3c, 0x9e, 0x05, 0x32, 0x66, 0x99, 0x43, 0x59, 0x9c, 0x5d, 0x0e,
+	0x30, 0x03, 0x2d, 0x3a, 0x2c, 0x11, 0x45, 0x40, 0xa7, 0x83, 0x58, 0xe8, 0x74, 0x1a, 0x0f, 0x4a,
+	0x64, 0x5f, 0xc6, 0x3c, 0x16, 0x51, 0x1c, 0xde, 0x7e, 0xeb, 0x7c, 0xab, 0xa0, 0xbd, 0x4e, 0xf2,
+	0xfe, 0x56, 0xd5, 0xe3, 0x34, 0x90, 0x01, 0x7e, 0x8a, 0x2c, 0xca, 0x92, 0x13, 0x31, 0x9b, 0x66,
+	0x6b, 0xbb, 0x6d, 0xbb, 0xab, 0xcb, 0xe3, 0xbe, 0x51, 0xaa, 0x33, 0xc3, 0xd7, 0x7a, 0xfc, 0x19,
+	0xed, 0xa9, 0x04, 0x4f, 0xa1, 0x1b, 0xd3, 0x38, 0xf9, 0x86, 0x54, 0x14, 0xe2, 0xa8, 0x0c, 0x71,
+	0x5e, 0x94, 0xdf, 0x46, 0x71, 0x66, 0xf8, 0xcb, 0x2c, 0xfc, 0x1e, 0xed, 0xa8, 0xab, 0x73, 0x1e,
+	0x50, 0xd1, 0x05, 0x4e, 0xaa, 0x0a, 0xee, 0xad, 0x85, 0x67, 0xe2, 0x02, 0xba, 0xc8, 0x39, 0xae,
+	0x21, 0x2b, 0x9d, 0x07, 0xa7, 0x86, 0xac, 0x34, 0x2b, 0xe7, 0x23, 0xda, 0x39, 0xe9, 0x05, 0x94,
+	0xc2, 0xe0, 0xb9, 0x08, 0x39, 0xbb, 0xc2, 0x04, 0x6d, 0x85, 0xe9, 0x85, 0xaa, 0x4b, 0xdd, 0xcf,
+	0x4c, 0x7c, 0x80, 0x2c, 0x31, 0x1d, 0x5e, 0xb0, 0x81, 0xca, 0xb6, 0xee, 0x6b, 0x2b, 0xb9, 0x0f,
+	0x86, 0x6c, 0x4c, 0xa5, 0x0a, 0xb4, 0xee, 0x6b, 0xcb, 0xf9, 0x51, 0x45, 0xff, 0x97, 0x26, 0x8e,
+	0x9f, 0xa1, 0x4d, 0x15, 0x9d, 0xae, 0xfe, 0x9d, 0xb5, 0xd9, 0x1d, 0x6f, 0x5c, 0xff, 0xbe, 0x6b,
+	0xf8, 0xa9, 0x07, 0xfe, 0x80, 0x76, 0x0b, 0xb3, 0x25, 0x48, 0xa5, 0x59, 0x6d, 0x6d, 0xb7, 0x1f,
+	0xae, 0x65, 0x74, 0xf2, 0x2e, 0x1a, 0xb8, 0xc4, 0xc1, 0x27, 0xc8, 0xea, 0x72, 0x36, 0x03, 0x4a,
+	0xaa, 0x8a, 0x78, 0x58, 0x46, 0x7c, 0xa1, 0x54, 0x9d, 0x28, 0xe2, 0x20, 0x84, 0x86, 0x69, 0xd7,
+	0x04, 0x12, 0x01, 0x8d, 0x21, 0x22, 0x1b, 0xeb, 0x21, 0xa7, 0x4a, 0xb5, 0x04, 0x49, 0x5d, 0x93,
+	0x21, 0xc8, 0xcf, 0xb1, 0x20, 0x9b, 0x8a, 0xf5, 0xa8, 0x8c, 0xa5, 0x29, 0x2f, 0x73, 0x3e, 0x9a,
+	0x58, 0xe4, 0x38, 0x0d, 0x44, 0x56, 0x36, 0xa5, 0x13, 0xf6, 0x9d, 0x9f, 0x15, 0xf4, 0x5f, 0xc9,
+	0x34, 0xe5, 0xba, 0x6f, 0x96, 0x74, 0xbf, 0x92, 0xef, 0xbe, 0xd2, 0x03, 0x8d, 0xf4, 0xf8, 0xd6,
+	0x7d, 0x6d, 0xe1, 0x06, 0xaa, 0x71, 0x08, 0x21, 0x9e, 0x00, 0x27, 0x1b, 0xea, 0xe5, 0x9f, 0x8d,
+	0xdf, 0xa1, 0x3d, 0x0e, 0x42, 0xf2, 0x34, 0xd6, 0xd7, 0x2c, 0x02, 0xb2, 0xd9, 0x34, 0x5b, 0xbb,
+	0xed, 0xfb, 0x65, 0x69, 0xfb, 0x45, 0xb9, 0xbf, 0xec, 0x8f, 0x5d, 0x84, 0x33, 0x7c, 0x36, 0x00,
+	0x10, 0x11, 0xab, 0x69, 0xb6, 0x6a, 0xfe, 0x8a, 0x17, 0xdc, 0x46, 0xfb, 0xd9, 0x6d, 0xbe, 0x96,
+	0x64, 0x4b, 0x85, 0xba, 0xf2, 0xcd, 0x21, 0xe8, 0x60, 0x45, 0xd5, 0x3a, 0x61, 0xff, 0xf8, 0xd5,
+	0xf5, 0xdc, 0x36, 0x6f, 0xe6, 0xb6, 0xf9, 0x67, 0x6e, 0x9b, 0xdf, 0x17, 0xb6, 0x71, 0xb3, 0xb0,
+	0x8d, 0x5f, 0x0b, 0xdb, 0xf8, 0xd4, 0xbe, 0x8c, 0x65, 0x6f, 0x7c, 0xe1, 0x86, 0x6c, 0xa8, 0x97,
+	0xa8, 0x84, 0xb0, 0xa7, 0x8f, 0x8f, 0xb3, 0x9d, 0xf6, 0x55, 0x6f, 0x35, 0x39, 0x1d, 0x81, 0xb8,
+	0xb0, 0xd4, 0x32, 0x7b, 0xf2, 0x77, 0x00, 0x12, 0xad, 0xd9, 0x7f, 0xed, 0x05, 0x00, 0x00,
+}
+
+func (m *AssetPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetPacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NoData != nil {
+		{
+			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AssetPacketData_TokenDefinition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPacketData_TokenDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TokenDefinition != nil {
+		{
+			size, err := m.TokenDefinition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AssetPacketData_TokenTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPacketData_TokenTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TokenTransfer != nil {
+		{
+			size, err := m.TokenTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NoData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenDefinitionPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenDefinitionPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenDefinitionPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Jurisdictions) > 0 {
+		for iNdEx := len(m.Jurisdictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Jurisdictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Denied) > 0 {
+		for iNdEx := len(m.Denied) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denied[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Frozen) > 0 {
+		for iNdEx := len(m.Frozen) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Frozen[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenDefinitionPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenDefinitionPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenDefinitionPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenTransferPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenTransferPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenTransferPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiverJurisdiction) > 0 {
+		i -= len(m.ReceiverJurisdiction)
+		copy(dAtA[i:], m.ReceiverJurisdiction)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ReceiverJurisdiction)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ReceiverAuthorized {
+		i--
+		if m.ReceiverAuthorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RestrictionMode != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.RestrictionMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenTransferPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenTransferPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenTransferPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AssetPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *AssetPacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *AssetPacketData_TokenDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenDefinition != nil {
+		l = m.TokenDefinition.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *AssetPacketData_TokenTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenTransfer != nil {
+		l = m.TokenTransfer.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ChannelEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenDefinitionPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Token.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if len(m.Frozen) > 0 {
+		for _, e := range m.Frozen {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if len(m.Denied) > 0 {
+		for _, e := range m.Denied {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if len(m.Jurisdictions) > 0 {
+		for _, e := range m.Jurisdictions {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenDefinitionPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TokenTransferPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.RestrictionMode != 0 {
+		n += 1 + sovPacket(uint64(m.RestrictionMode))
+	}
+	if m.ReceiverAuthorized {
+		n += 2
+	}
+	l = len(m.ReceiverJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenTransferPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AssetPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &AssetPacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDefinition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TokenDefinitionPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &AssetPacketData_TokenDefinition{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TokenTransferPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &AssetPacketData_TokenTransfer{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenDefinitionPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenDefinitionPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenDefinitionPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, TokenAuthorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Frozen = append(m.Frozen, FrozenAddress{})
+			if err := m.Frozen[len(m.Frozen)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denied", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denied = append(m.Denied, DeniedAddress{})
+			if err := m.Denied[len(m.Denied)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdictions = append(m.Jurisdictions, AddressJurisdiction{})
+			if err := m.Jurisdictions[len(m.Jurisdictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenDefinitionPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenDefinitionPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenDefinitionPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenTransferPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenTransferPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenTransferPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictionMode", wireType)
+			}
+			m.RestrictionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestrictionMode |= RestrictionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAuthorized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiverAuthorized = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenTransferPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenTransferPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenTransferPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,35 +1,57 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// Parameter store keys
+var (
+	KeyReplicaChannels = []byte("ReplicaChannels")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(replicaChannels []string) Params {
+	return Params{
+		ReplicaChannels: replicaChannels,
+	}
 }
 
-// DefaultParams returns a default set of parameters
+// DefaultParams returns a default set of parameters, no channel can replicate tokens
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(nil)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyReplicaChannels, &p.ReplicaChannels, validateReplicaChannels),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	return validateReplicaChannels(p.ReplicaChannels)
+}
+
+// IsReplicaChannel checks if token definitions received on channel are accepted
+func (p Params) IsReplicaChannel(channel string) bool {
+	for _, replicaChannel := range p.ReplicaChannels {
+		if replicaChannel == channel {
+			return true
+		}
+	}
+	return false
 }
 
 // String implements the Stringer interface.
@@ -37,3 +59,22 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateReplicaChannels(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, channel := range v {
+		if err := host.ChannelIdentifierValidator(channel); err != nil {
+			return fmt.Errorf("invalid replica channel %s: %w", channel, err)
+		}
+		if seen[channel] {
+			return fmt.Errorf("duplicate replica channel %s", channel)
+		}
+		seen[channel] = true
+	}
+	return nil
+}
@@ -25,6 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// replicaChannels are the asset channels whose token definitions create and
+	// update replicas of the tokens issued on the counterparty chain, a channel
+	// is approved by governance before the counterparty can register symbols
+	ReplicaChannels []string `protobuf:"bytes,1,rep,name=replicaChannels,proto3" json:"replicaChannels,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +63,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetReplicaChannels() []string {
+	if m != nil {
+		return m.ReplicaChannels
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "realionetwork.asset.v1.Params")
 }
@@ -68,18 +79,19 @@ func init() {
 }
 
 var fileDescriptor_d68d5b1218748d2a = []byte{
-	// 162 bytes of a gzipped FileDescriptorProto
+	// 187 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x4a, 0x4d, 0xcc,
 	0xc9, 0xcc, 0xcf, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d, 0xd1,
 	0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
 	0x12, 0x43, 0x51, 0xa4, 0x07, 0x56, 0xa4, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
-	0x56, 0xa2, 0x0f, 0x62, 0x41, 0x54, 0x2b, 0xf1, 0x71, 0xb1, 0x05, 0x80, 0x75, 0x5b, 0xb1, 0xcc,
-	0x58, 0x20, 0xcf, 0xe0, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0x46, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10, 0x2b, 0x4a, 0x52,
-	0x93, 0x33, 0xa0, 0x4c, 0x5d, 0x98, 0x9b, 0x2a, 0xa0, 0xae, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x5b, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x30, 0xc3, 0xf2, 0x1b, 0xb9, 0x00,
-	0x00, 0x00,
+	0x56, 0xa2, 0x0f, 0x62, 0x41, 0x54, 0x2b, 0x59, 0x70, 0xb1, 0x05, 0x80, 0x75, 0x0b, 0x69, 0x70,
+	0xf1, 0x17, 0xa5, 0x16, 0xe4, 0x64, 0x26, 0x27, 0x3a, 0x67, 0x24, 0xe6, 0xe5, 0xa5, 0xe6, 0x14,
+	0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0xa1, 0x0b, 0x5b, 0xb1, 0xcc, 0x58, 0x20, 0xcf, 0xe0,
+	0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
+	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x46, 0xe9, 0x99, 0x25,
+	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x10, 0xc7, 0x94, 0xa4, 0x26, 0x67, 0x40, 0x99,
+	0xba, 0x30, 0xd7, 0x57, 0x40, 0xdd, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0x8e,
+	0x31, 0x60, 0x00, 0xd8, 0x28, 0x5f, 0x2e, 0xe3, 0x00, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -102,6 +114,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReplicaChannels) > 0 {
+		for iNdEx := len(m.ReplicaChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReplicaChannels[iNdEx])
+			copy(dAtA[i:], m.ReplicaChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ReplicaChannels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -122,6 +143,12 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.ReplicaChannels) > 0 {
+		for _, s := range m.ReplicaChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -160,6 +187,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicaChannels = append(m.ReplicaChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
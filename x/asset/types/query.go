@@ -1,6 +1,73 @@
 package types
 
+import "github.com/cosmos/cosmos-sdk/types/query"
+
 // NewQueryTokenRequest creates a new instance of QueryTokenRequest.
 func NewQueryTokenRequest(symbol string) *QueryTokenRequest {
 	return &QueryTokenRequest{Symbol: symbol}
 }
+
+// NewQueryRedemptionRequestsRequest creates a new instance of QueryRedemptionRequestsRequest.
+func NewQueryRedemptionRequestsRequest(symbol string) *QueryRedemptionRequestsRequest {
+	return &QueryRedemptionRequestsRequest{Symbol: symbol}
+}
+
+// NewQueryRolesRequest creates a new instance of QueryRolesRequest.
+func NewQueryRolesRequest(symbol string) *QueryRolesRequest {
+	return &QueryRolesRequest{Symbol: symbol}
+}
+
+// NewQueryIsFrozenRequest creates a new instance of QueryIsFrozenRequest.
+func NewQueryIsFrozenRequest(symbol string, address string) *QueryIsFrozenRequest {
+	return &QueryIsFrozenRequest{Symbol: symbol, Address: address}
+}
+
+// NewQueryFrozenAddressesRequest creates a new instance of QueryFrozenAddressesRequest.
+func NewQueryFrozenAddressesRequest(symbol string) *QueryFrozenAddressesRequest {
+	return &QueryFrozenAddressesRequest{Symbol: symbol}
+}
+
+// NewQueryAuthorizedAddressesRequest creates a new instance of QueryAuthorizedAddressesRequest.
+func NewQueryAuthorizedAddressesRequest(symbol string, pagination *query.PageRequest) *QueryAuthorizedAddressesRequest {
+	return &QueryAuthorizedAddressesRequest{Symbol: symbol, Pagination: pagination}
+}
+
+// NewQueryAddressAuthorizationsRequest creates a new instance of QueryAddressAuthorizationsRequest.
+func NewQueryAddressAuthorizationsRequest(address string, pagination *query.PageRequest) *QueryAddressAuthorizationsRequest {
+	return &QueryAddressAuthorizationsRequest{Address: address, Pagination: pagination}
+}
+
+// NewQueryHolderCountRequest creates a new instance of QueryHolderCountRequest.
+func NewQueryHolderCountRequest(symbol string) *QueryHolderCountRequest {
+	return &QueryHolderCountRequest{Symbol: symbol}
+}
+
+// NewQueryIsDeniedRequest creates a new instance of QueryIsDeniedRequest.
+func NewQueryIsDeniedRequest(symbol string, address string) *QueryIsDeniedRequest {
+	return &QueryIsDeniedRequest{Symbol: symbol, Address: address}
+}
+
+// NewQueryDeniedAddressesRequest creates a new instance of QueryDeniedAddressesRequest.
+func NewQueryDeniedAddressesRequest(symbol string, pagination *query.PageRequest) *QueryDeniedAddressesRequest {
+	return &QueryDeniedAddressesRequest{Symbol: symbol, Pagination: pagination}
+}
+
+// NewQueryDistributionRequest creates a new instance of QueryDistributionRequest.
+func NewQueryDistributionRequest(id uint64) *QueryDistributionRequest {
+	return &QueryDistributionRequest{Id: id}
+}
+
+// NewQueryPendingClaimsRequest creates a new instance of QueryPendingClaimsRequest.
+func NewQueryPendingClaimsRequest(address string, pagination *query.PageRequest) *QueryPendingClaimsRequest {
+	return &QueryPendingClaimsRequest{Address: address, Pagination: pagination}
+}
+
+// NewQueryBalanceAtRequest creates a new instance of QueryBalanceAtRequest.
+func NewQueryBalanceAtRequest(symbol string, address string, snapshotID uint64) *QueryBalanceAtRequest {
+	return &QueryBalanceAtRequest{Symbol: symbol, Address: address, SnapshotId: snapshotID}
+}
+
+// NewQuerySettlementRequest creates a new instance of QuerySettlementRequest.
+func NewQuerySettlementRequest(id uint64) *QuerySettlementRequest {
+	return &QuerySettlementRequest{Id: id}
+}
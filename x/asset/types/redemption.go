@@ -0,0 +1,9 @@
+package types
+
+func NewRedemptionRequest(symbol string, holder string, amount string) RedemptionRequest {
+	return RedemptionRequest{
+		Symbol: symbol,
+		Holder: holder,
+		Amount: amount,
+	}
+}
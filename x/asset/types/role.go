@@ -0,0 +1,39 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewRoleAssignment(symbol string, address string, role Role) RoleAssignment {
+	return RoleAssignment{
+		Symbol:  symbol,
+		Address: address,
+		Role:    role,
+	}
+}
+
+// ParseRole returns the role from its name, either "ROLE_ISSUER" or "issuer"
+func ParseRole(name string) (Role, error) {
+	upper := strings.ToUpper(name)
+	if !strings.HasPrefix(upper, "ROLE_") {
+		upper = "ROLE_" + upper
+	}
+	role := Role(Role_value[upper])
+	if err := ValidateRole(role); err != nil {
+		return RoleUnspecified, sdkerrors.ErrInvalidRequest.Wrapf("unknown role %s", name)
+	}
+	return role, nil
+}
+
+// ValidateRole returns an error if the role cannot be delegated
+func ValidateRole(role Role) error {
+	if role == RoleUnspecified {
+		return sdkerrors.ErrInvalidRequest.Wrap("role must be specified")
+	}
+	if _, ok := Role_name[int32(role)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown role %d", role)
+	}
+	return nil
+}
@@ -0,0 +1,459 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: realionetwork/asset/v1/role.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Role defines a privileged duty on a token that can be delegated by the
+// token manager. The manager implicitly holds every role.
+type Role int32
+
+const (
+	// ROLE_UNSPECIFIED defines an invalid role.
+	RoleUnspecified Role = 0
+	// ROLE_ADMIN can update the token and grant or revoke roles.
+	RoleAdmin Role = 1
+	// ROLE_ISSUER can mint and redeem units of the token.
+	RoleIssuer Role = 2
+	// ROLE_COMPLIANCE can authorize and unauthorize addresses.
+	RoleCompliance Role = 3
+	// ROLE_FREEZER can freeze and unfreeze addresses.
+	RoleFreezer Role = 4
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_ADMIN",
+	2: "ROLE_ISSUER",
+	3: "ROLE_COMPLIANCE",
+	4: "ROLE_FREEZER",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED": 0,
+	"ROLE_ADMIN":       1,
+	"ROLE_ISSUER":      2,
+	"ROLE_COMPLIANCE":  3,
+	"ROLE_FREEZER":     4,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_235bbc1d939e665c, []int{0}
+}
+
+// RoleAssignment represents a role held by an address on a token
+type RoleAssignment struct {
+	Symbol  string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role    Role   `protobuf:"varint,3,opt,name=role,proto3,enum=realionetwork.asset.v1.Role" json:"role,omitempty"`
+}
+
+func (m *RoleAssignment) Reset()         { *m = RoleAssignment{} }
+func (m *RoleAssignment) String() string { return proto.CompactTextString(m) }
+func (*RoleAssignment) ProtoMessage()    {}
+func (*RoleAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_235bbc1d939e665c, []int{0}
+}
+func (m *RoleAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleAssignment.Merge(m, src)
+}
+func (m *RoleAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleAssignment proto.InternalMessageInfo
+
+func (m *RoleAssignment) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *RoleAssignment) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RoleAssignment) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return RoleUnspecified
+}
+
+func init() {
+	proto.RegisterEnum("realionetwork.asset.v1.Role", Role_name, Role_value)
+	proto.RegisterType((*RoleAssignment)(nil), "realionetwork.asset.v1.RoleAssignment")
+}
+
+func init() { proto.RegisterFile("realionetwork/asset/v1/role.proto", fileDescriptor_235bbc1d939e665c) }
+
+var fileDescriptor_235bbc1d939e665c = []byte{
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x33, 0xbb, 0x61, 0x65, 0x67, 0xb5, 0x0d, 0xa3, 0x2c, 0x21, 0x68, 0xcc, 0x7a, 0x71,
+	0x15, 0x4c, 0xdc, 0xf5, 0x09, 0x6a, 0x76, 0x0a, 0x81, 0x6e, 0x5b, 0x52, 0x7a, 0xe9, 0x45, 0xd2,
+	0xe4, 0x33, 0x0d, 0x26, 0x99, 0x30, 0x33, 0xad, 0xd6, 0x27, 0x90, 0x9c, 0x7c, 0x81, 0x9c, 0x7c,
+	0x14, 0x2f, 0x1e, 0x7b, 0xf4, 0x28, 0xed, 0x8b, 0x48, 0xa6, 0xed, 0x41, 0xf0, 0xf6, 0xfd, 0xf9,
+	0xfd, 0xe0, 0xfb, 0xf8, 0xfe, 0xf8, 0x8a, 0x43, 0x94, 0x67, 0xac, 0x04, 0xf9, 0x99, 0xf1, 0x4f,
+	0x5e, 0x24, 0x04, 0x48, 0x6f, 0x75, 0xe3, 0x71, 0x96, 0x83, 0x5b, 0x71, 0x26, 0x19, 0xb9, 0xfc,
+	0x47, 0x71, 0x95, 0xe2, 0xae, 0x6e, 0xac, 0x27, 0x29, 0x4b, 0x99, 0x52, 0xbc, 0x76, 0xda, 0xdb,
+	0x2f, 0x24, 0xee, 0x84, 0x2c, 0x87, 0x9e, 0x10, 0x59, 0x5a, 0x16, 0x50, 0x4a, 0x72, 0x89, 0xcf,
+	0xc4, 0xba, 0x98, 0xb3, 0xdc, 0x44, 0x0e, 0xba, 0x3e, 0x0f, 0x0f, 0x89, 0x98, 0xf8, 0x41, 0x94,
+	0x24, 0x1c, 0x84, 0x30, 0x4f, 0x14, 0x38, 0x46, 0xf2, 0x16, 0xeb, 0xed, 0x7e, 0xf3, 0xd4, 0x41,
+	0xd7, 0x9d, 0xdb, 0xa7, 0xee, 0xff, 0x0f, 0x70, 0xdb, 0x3d, 0xa1, 0x32, 0x5f, 0xff, 0x44, 0x58,
+	0x6f, 0x23, 0x79, 0x85, 0x8d, 0x70, 0x34, 0xa0, 0x1f, 0xa6, 0xc3, 0xc9, 0x98, 0xfa, 0x41, 0x3f,
+	0xa0, 0x77, 0x86, 0x66, 0x3d, 0xae, 0x1b, 0xa7, 0xdb, 0xf2, 0x69, 0x29, 0x2a, 0x88, 0xb3, 0x8f,
+	0x19, 0x24, 0xe4, 0x19, 0xc6, 0x4a, 0xed, 0xdd, 0xdd, 0x07, 0x43, 0x03, 0x59, 0x8f, 0xea, 0xc6,
+	0x39, 0x57, 0xb7, 0x27, 0x45, 0x56, 0x92, 0xe7, 0xf8, 0x42, 0xe1, 0x60, 0x32, 0x99, 0xd2, 0xd0,
+	0x38, 0xb1, 0x3a, 0x75, 0xe3, 0xe0, 0x96, 0x07, 0x42, 0x2c, 0x81, 0x93, 0x97, 0xb8, 0xab, 0x04,
+	0x7f, 0x74, 0x3f, 0x1e, 0x04, 0xbd, 0xa1, 0x4f, 0x8d, 0x53, 0x8b, 0xd4, 0x8d, 0xa3, 0x1e, 0xe0,
+	0xb3, 0xa2, 0xca, 0xb3, 0xa8, 0x8c, 0x81, 0x5c, 0xe1, 0x87, 0x4a, 0xec, 0x87, 0x94, 0xce, 0x68,
+	0x68, 0xe8, 0x56, 0xb7, 0x6e, 0x9c, 0x8b, 0xd6, 0xea, 0x73, 0x80, 0xaf, 0xc0, 0x2d, 0xfd, 0xdb,
+	0x0f, 0x5b, 0x7b, 0x3f, 0xf8, 0xb5, 0xb5, 0xd1, 0x66, 0x6b, 0xa3, 0x3f, 0x5b, 0x1b, 0x7d, 0xdf,
+	0xd9, 0xda, 0x66, 0x67, 0x6b, 0xbf, 0x77, 0xb6, 0x36, 0xbb, 0x4d, 0x33, 0xb9, 0x58, 0xce, 0xdd,
+	0x98, 0x15, 0xde, 0xfe, 0x1b, 0x12, 0xe2, 0xc5, 0x61, 0x7c, 0x73, 0x6c, 0xef, 0xcb, 0xa1, 0x3f,
+	0xb9, 0xae, 0x40, 0xcc, 0xcf, 0x54, 0x21, 0xef, 0xfe, 0x0e, 0x00, 0x4d, 0x36, 0xb3, 0xa3, 0xe3,
+	0x01, 0x00, 0x00,
+}
+
+func (m *RoleAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRole(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRole(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovRole(uint64(m.Role))
+	}
+	return n
+}
+
+func sovRole(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRole(x uint64) (n int) {
+	return sovRole(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RoleAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRole(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRole
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRole
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRole
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRole        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRole          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRole = fmt.Errorf("proto: unexpected end of group")
+)
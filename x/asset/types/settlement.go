@@ -0,0 +1,20 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxSettlementExpiriesPerBlock bounds the expired settlements refunded by the EndBlocker in a block
+const MaxSettlementExpiriesPerBlock = 100
+
+// validateSettlementLeg checks that a leg names a token and a positive amount of it.
+func validateSettlementLeg(leg SettlementLeg) error {
+	if leg.Symbol == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing settlement leg symbol")
+	}
+	if amount, ok := math.NewIntFromString(leg.Amount); !ok || !amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid %s leg amount %s", leg.Symbol, leg.Amount)
+	}
+	return nil
+}
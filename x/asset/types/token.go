@@ -1,6 +1,51 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxDecimals is the largest exponent a token can use, tokens created before decimals were configurable all use it
+const MaxDecimals = 18
+
+// ParseDecimals parses the decimals of a new token, empty means MaxDecimals
+func ParseDecimals(decimals string) (uint32, error) {
+	if decimals == "" {
+		return MaxDecimals, nil
+	}
+	parsed, err := strconv.ParseUint(decimals, 10, 32)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid decimals %s", decimals)
+	}
+	if parsed > MaxDecimals {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "decimals %d exceeds %d", parsed, MaxDecimals)
+	}
+	return uint32(parsed), nil
+}
+
+// symbolRegex is the pattern of a lower cased symbol, symbols are used as
+// store key segments so they can't contain a separator
+var symbolRegex = regexp.MustCompile(`^[a-z][a-z0-9]{2,126}$`)
+
+// ValidateSymbol checks that the symbol can name a token and its base denom
+func ValidateSymbol(symbol string) error {
+	if !symbolRegex.MatchString(strings.ToLower(symbol)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid symbol %s", symbol)
+	}
+	return nil
+}
+
+// MaxBlackoutWindows bounds the schedule checked on every transfer of a token
+const MaxBlackoutWindows = 50
+
+// MaxAuthorizationExpiriesPerBlock bounds the expired authorizations removed by the EndBlocker in a block
+const MaxAuthorizationExpiriesPerBlock = 100
 
 func NewToken(name string, symbol string, total string, manager string, authorizationRequired bool) Token {
 	return Token{
@@ -9,45 +54,53 @@ func NewToken(name string, symbol string, total string, manager string, authoriz
 		Total:                 total,
 		Manager:               manager,
 		AuthorizationRequired: authorizationRequired,
-		Authorized:            []*TokenAuthorization{},
 	}
 }
 
-func NewAuthorization(address sdk.Address) *TokenAuthorization {
-	return &TokenAuthorization{Address: address.String(), Authorized: true}
+func NewAuthorization(symbol string, address sdk.Address) TokenAuthorization {
+	return TokenAuthorization{Symbol: symbol, Address: address.String(), Authorized: true}
 }
 
-func (t *Token) AuthorizeAddress(addr sdk.Address) *Token {
-	found := false
-	for _, a := range t.Authorized {
-		if a.Address == addr.String() {
-			a.Authorized = true
-			found = true
-			break
-		}
-	}
-	if !found {
-		newAuthorized := NewAuthorization(addr)
-		t.Authorized = append(t.Authorized, newAuthorized)
-	}
-	return t
+// IsExpired returns whether the authorization has lapsed at the given block time
+func (a TokenAuthorization) IsExpired(blockTime time.Time) bool {
+	return a.Expiry != nil && !blockTime.Before(*a.Expiry)
 }
 
-func (t *Token) UnAuthorizeAddress(addr sdk.Address) *Token {
-	for _, a := range t.Authorized {
-		if a.Address == addr.String() {
-			a.Authorized = false
-			break
+// InBlackout returns whether transfers of the token are blocked at the given block time
+func (t Token) InBlackout(blockTime time.Time) bool {
+	for _, window := range t.BlackoutWindows {
+		if window.Contains(blockTime) {
+			return true
 		}
 	}
-	return t
+	return false
 }
 
-func (t *Token) AddressIsAuthorized(addr sdk.AccAddress) bool {
-	for _, a := range t.Authorized {
-		if a.Address == addr.String() && a.Authorized {
-			return true
-		}
+// Contains returns whether the given block time falls within the window
+func (w BlackoutWindow) Contains(blockTime time.Time) bool {
+	return !blockTime.Before(w.Start) && blockTime.Before(w.End)
+}
+
+// BaseUnits converts amount whole units of the token into its base denom
+func (t Token) BaseUnits(amount math.Int) math.Int {
+	return amount.Mul(math.NewIntWithDecimal(1, int(t.Decimals)))
+}
+
+// EffectiveRestrictionMode returns who can transact with the token, tokens
+// without a restriction mode follow their authorizationRequired flag
+func (t Token) EffectiveRestrictionMode() RestrictionMode {
+	if t.RestrictionMode != RestrictionModeUnspecified {
+		return t.RestrictionMode
 	}
-	return false
+	if t.AuthorizationRequired {
+		return RestrictionModeAllowlist
+	}
+	return RestrictionModeOpen
+}
+
+// SetRestrictionMode sets the restriction mode of the token and keeps the
+// authorizationRequired flag in line with it
+func (t *Token) SetRestrictionMode(mode RestrictionMode) {
+	t.RestrictionMode = mode
+	t.AuthorizationRequired = mode == RestrictionModeAllowlist
 }
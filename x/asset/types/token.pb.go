@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,14 +27,87 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RestrictionMode defines who can transact with a token
+type RestrictionMode int32
+
+const (
+	// RESTRICTION_MODE_UNSPECIFIED follows the authorizationRequired flag of
+	// tokens created before restriction modes.
+	RestrictionModeUnspecified RestrictionMode = 0
+	// RESTRICTION_MODE_OPEN lets anyone transact.
+	RestrictionModeOpen RestrictionMode = 1
+	// RESTRICTION_MODE_ALLOWLIST only lets authorized addresses transact.
+	RestrictionModeAllowlist RestrictionMode = 2
+	// RESTRICTION_MODE_DENYLIST lets anyone but denied addresses transact.
+	RestrictionModeDenylist RestrictionMode = 3
+)
+
+var RestrictionMode_name = map[int32]string{
+	0: "RESTRICTION_MODE_UNSPECIFIED",
+	1: "RESTRICTION_MODE_OPEN",
+	2: "RESTRICTION_MODE_ALLOWLIST",
+	3: "RESTRICTION_MODE_DENYLIST",
+}
+
+var RestrictionMode_value = map[string]int32{
+	"RESTRICTION_MODE_UNSPECIFIED": 0,
+	"RESTRICTION_MODE_OPEN":        1,
+	"RESTRICTION_MODE_ALLOWLIST":   2,
+	"RESTRICTION_MODE_DENYLIST":    3,
+}
+
+func (x RestrictionMode) String() string {
+	return proto.EnumName(RestrictionMode_name, int32(x))
+}
+
+func (RestrictionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2f83138fc60a3176, []int{0}
+}
+
 // Token represents an asset in the module
 type Token struct {
-	Name                  string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol                string                `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Total                 string                `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
-	AuthorizationRequired bool                  `protobuf:"varint,4,opt,name=authorizationRequired,proto3" json:"authorizationRequired,omitempty"`
-	Manager               string                `protobuf:"bytes,5,opt,name=manager,proto3" json:"manager,omitempty"`
-	Authorized            []*TokenAuthorization `protobuf:"bytes,6,rep,name=authorized,proto3" json:"authorized,omitempty"`
+	Name                  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol                string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Total                 string `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	AuthorizationRequired bool   `protobuf:"varint,4,opt,name=authorizationRequired,proto3" json:"authorizationRequired,omitempty"`
+	Manager               string `protobuf:"bytes,5,opt,name=manager,proto3" json:"manager,omitempty"`
+	// authorized is no longer written, authorizations are indexed by
+	// symbol/address in their own store since consensus version 2
+	Authorized []*TokenAuthorization `protobuf:"bytes,6,rep,name=authorized,proto3" json:"authorized,omitempty"`
+	MaxSupply  string                `protobuf:"bytes,7,opt,name=maxSupply,proto3" json:"maxSupply,omitempty"`
+	// pendingManager is the address proposed to take over the token, it
+	// becomes the manager once it accepts
+	PendingManager string `protobuf:"bytes,8,opt,name=pendingManager,proto3" json:"pendingManager,omitempty"`
+	// paused halts every transfer of the token outside of module accounts
+	Paused bool `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	// clawbackEnabled allows MsgForceTransfer on the token, it can only be set
+	// at creation
+	ClawbackEnabled bool `protobuf:"varint,10,opt,name=clawbackEnabled,proto3" json:"clawbackEnabled,omitempty"`
+	// decimals is the exponent between the token and its base denom
+	Decimals    uint32 `protobuf:"varint,11,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Description string `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	Uri         string `protobuf:"bytes,13,opt,name=uri,proto3" json:"uri,omitempty"`
+	UriHash     string `protobuf:"bytes,14,opt,name=uriHash,proto3" json:"uriHash,omitempty"`
+	// maxHolders optionally caps the number of accounts holding the token,
+	// module accounts are not counted, 0 means unlimited
+	MaxHolders uint64 `protobuf:"varint,15,opt,name=maxHolders,proto3" json:"maxHolders,omitempty"`
+	// maxBalancePerHolder optionally caps the balance of each holder in whole
+	// units, the manager and module accounts are exempt, empty means unlimited
+	MaxBalancePerHolder string `protobuf:"bytes,16,opt,name=maxBalancePerHolder,proto3" json:"maxBalancePerHolder,omitempty"`
+	// blackoutWindows are the time ranges during which the token cannot be
+	// transferred outside of module accounts
+	BlackoutWindows []BlackoutWindow `protobuf:"bytes,17,rep,name=blackoutWindows,proto3" json:"blackoutWindows"`
+	// complianceRules are the rules checked in order on every transfer of the
+	// token after the mandatory blackout, freeze, authorization and max_balance
+	// rules
+	ComplianceRules []ComplianceRuleConfig `protobuf:"bytes,18,rep,name=complianceRules,proto3" json:"complianceRules"`
+	// restrictionMode decides who can transact with the token
+	RestrictionMode RestrictionMode `protobuf:"varint,19,opt,name=restrictionMode,proto3,enum=realionetwork.asset.v1.RestrictionMode" json:"restrictionMode,omitempty"`
+	// ibcEnabled lets a token in allowlist mode be sent over IBC transfer
+	IbcEnabled bool `protobuf:"varint,20,opt,name=ibcEnabled,proto3" json:"ibcEnabled,omitempty"`
+	// originChannel is the asset channel the token was replicated over, it is
+	// empty for tokens issued on this chain
+	OriginChannel string `protobuf:"bytes,21,opt,name=originChannel,proto3" json:"originChannel,omitempty"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
@@ -108,8 +185,225 @@ func (m *Token) GetAuthorized() []*TokenAuthorization {
 	return nil
 }
 
+func (m *Token) GetMaxSupply() string {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return ""
+}
+
+func (m *Token) GetPendingManager() string {
+	if m != nil {
+		return m.PendingManager
+	}
+	return ""
+}
+
+func (m *Token) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Token) GetClawbackEnabled() bool {
+	if m != nil {
+		return m.ClawbackEnabled
+	}
+	return false
+}
+
+func (m *Token) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *Token) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Token) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *Token) GetUriHash() string {
+	if m != nil {
+		return m.UriHash
+	}
+	return ""
+}
+
+func (m *Token) GetMaxHolders() uint64 {
+	if m != nil {
+		return m.MaxHolders
+	}
+	return 0
+}
+
+func (m *Token) GetMaxBalancePerHolder() string {
+	if m != nil {
+		return m.MaxBalancePerHolder
+	}
+	return ""
+}
+
+func (m *Token) GetBlackoutWindows() []BlackoutWindow {
+	if m != nil {
+		return m.BlackoutWindows
+	}
+	return nil
+}
+
+func (m *Token) GetComplianceRules() []ComplianceRuleConfig {
+	if m != nil {
+		return m.ComplianceRules
+	}
+	return nil
+}
+
+func (m *Token) GetRestrictionMode() RestrictionMode {
+	if m != nil {
+		return m.RestrictionMode
+	}
+	return RestrictionModeUnspecified
+}
+
+func (m *Token) GetIbcEnabled() bool {
+	if m != nil {
+		return m.IbcEnabled
+	}
+	return false
+}
+
+func (m *Token) GetOriginChannel() string {
+	if m != nil {
+		return m.OriginChannel
+	}
+	return ""
+}
+
+// ComplianceRuleConfig enables a compliance rule on a token
+type ComplianceRuleConfig struct {
+	// id of the rule, see the ComplianceRule implementations
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// params of the rule, their format is defined by the rule
+	Params string `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *ComplianceRuleConfig) Reset()         { *m = ComplianceRuleConfig{} }
+func (m *ComplianceRuleConfig) String() string { return proto.CompactTextString(m) }
+func (*ComplianceRuleConfig) ProtoMessage()    {}
+func (*ComplianceRuleConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f83138fc60a3176, []int{1}
+}
+func (m *ComplianceRuleConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ComplianceRuleConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ComplianceRuleConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ComplianceRuleConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ComplianceRuleConfig.Merge(m, src)
+}
+func (m *ComplianceRuleConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ComplianceRuleConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ComplianceRuleConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ComplianceRuleConfig proto.InternalMessageInfo
+
+func (m *ComplianceRuleConfig) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ComplianceRuleConfig) GetParams() string {
+	if m != nil {
+		return m.Params
+	}
+	return ""
+}
+
+// BlackoutWindow blocks transfers of a token from start (inclusive) until end
+// (exclusive)
+type BlackoutWindow struct {
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	End   time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+}
+
+func (m *BlackoutWindow) Reset()         { *m = BlackoutWindow{} }
+func (m *BlackoutWindow) String() string { return proto.CompactTextString(m) }
+func (*BlackoutWindow) ProtoMessage()    {}
+func (*BlackoutWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f83138fc60a3176, []int{2}
+}
+func (m *BlackoutWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlackoutWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlackoutWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlackoutWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlackoutWindow.Merge(m, src)
+}
+func (m *BlackoutWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlackoutWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlackoutWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlackoutWindow proto.InternalMessageInfo
+
+func (m *BlackoutWindow) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *BlackoutWindow) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("realionetwork.asset.v1.RestrictionMode", RestrictionMode_name, RestrictionMode_value)
 	proto.RegisterType((*Token)(nil), "realionetwork.asset.v1.Token")
+	proto.RegisterType((*ComplianceRuleConfig)(nil), "realionetwork.asset.v1.ComplianceRuleConfig")
+	proto.RegisterType((*BlackoutWindow)(nil), "realionetwork.asset.v1.BlackoutWindow")
 }
 
 func init() {
@@ -117,25 +411,59 @@ func init() {
 }
 
 var fileDescriptor_2f83138fc60a3176 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x6b, 0xda, 0x06, 0x30, 0x9b, 0x55, 0x2a, 0xab, 0x83, 0x15, 0x75, 0x8a, 0x90, 0x70,
-	0xd4, 0xc2, 0x0b, 0xc0, 0x88, 0x98, 0x22, 0x26, 0x36, 0xa7, 0xb5, 0x92, 0xa8, 0x49, 0xae, 0x38,
-	0x97, 0x42, 0x79, 0x0a, 0x1e, 0x8b, 0xb1, 0x23, 0x23, 0x4a, 0xc4, 0x7b, 0xa0, 0xba, 0x89, 0x44,
-	0xa4, 0x8a, 0xed, 0xff, 0x7d, 0xff, 0xfd, 0x27, 0x7f, 0x74, 0x6a, 0xb4, 0x4a, 0x13, 0xc8, 0x35,
-	0xbe, 0x82, 0x59, 0xf9, 0xaa, 0x28, 0x34, 0xfa, 0x9b, 0x99, 0x8f, 0xb0, 0xd2, 0xb9, 0x5c, 0x1b,
-	0x40, 0x60, 0xe3, 0x4e, 0x46, 0xda, 0x8c, 0xdc, 0xcc, 0x26, 0xa3, 0x08, 0x22, 0xb0, 0x11, 0x7f,
-	0xaf, 0x0e, 0xe9, 0x89, 0xff, 0x5f, 0xa3, 0x2a, 0x31, 0x06, 0x93, 0xbc, 0x2b, 0x4c, 0xa0, 0xa9,
-	0x9f, 0xfe, 0x10, 0x3a, 0x7c, 0xda, 0x0f, 0x19, 0xa3, 0x83, 0x5c, 0x65, 0x9a, 0x13, 0x97, 0x78,
-	0xe7, 0x81, 0xd5, 0x6c, 0x4c, 0x9d, 0x62, 0x9b, 0x85, 0x90, 0xf2, 0x13, 0xfb, 0xda, 0x38, 0x36,
-	0xa2, 0x43, 0x04, 0x54, 0x29, 0xef, 0xdb, 0xe7, 0x83, 0x61, 0xb7, 0xf4, 0xb2, 0x73, 0x22, 0xd0,
-	0x2f, 0x65, 0x62, 0xf4, 0x92, 0x0f, 0x5c, 0xe2, 0x9d, 0x05, 0xc7, 0x87, 0x8c, 0xd3, 0xd3, 0x4c,
-	0xe5, 0x2a, 0xd2, 0x86, 0x0f, 0x6d, 0x5b, 0x6b, 0xd9, 0x03, 0xa5, 0xed, 0x8a, 0x5e, 0x72, 0xc7,
-	0xed, 0x7b, 0x17, 0xf3, 0x2b, 0x79, 0x9c, 0x87, 0xb4, 0x9f, 0xb8, 0xeb, 0x5c, 0xf8, 0xb3, 0x7d,
-	0xff, 0xf8, 0x59, 0x09, 0xb2, 0xab, 0x04, 0xf9, 0xae, 0x04, 0xf9, 0xa8, 0x45, 0x6f, 0x57, 0x8b,
-	0xde, 0x57, 0x2d, 0x7a, 0xcf, 0xf3, 0x28, 0xc1, 0xb8, 0x0c, 0xe5, 0x02, 0xb2, 0x86, 0x1e, 0xea,
-	0x45, 0xdc, 0xc8, 0xeb, 0x96, 0xe4, 0x5b, 0xc3, 0x12, 0xb7, 0x6b, 0x5d, 0x84, 0x8e, 0x85, 0x77,
-	0xf3, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x66, 0x07, 0x38, 0xad, 0xc1, 0x01, 0x00, 0x00,
+	// 823 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0xe3, 0x44,
+	0x18, 0x8d, 0x93, 0xb4, 0xdb, 0x7e, 0xa5, 0x49, 0x98, 0xb6, 0xbb, 0x83, 0xa9, 0x5c, 0xab, 0x42,
+	0x4b, 0xb4, 0x02, 0x87, 0x0d, 0x88, 0xc3, 0x0a, 0x21, 0x9a, 0x34, 0x68, 0x83, 0xda, 0xa6, 0xb8,
+	0x59, 0x56, 0x20, 0xa4, 0xd5, 0xc4, 0x9e, 0x3a, 0xa3, 0xda, 0x33, 0xc6, 0x33, 0xde, 0xb6, 0x9c,
+	0x91, 0x40, 0x3d, 0xed, 0x1f, 0xe8, 0x89, 0x3f, 0xb3, 0xc7, 0x3d, 0x72, 0x02, 0xd4, 0xfe, 0x09,
+	0x8e, 0xc8, 0x13, 0x07, 0x1a, 0x37, 0x45, 0xe2, 0x36, 0xdf, 0x9b, 0xf7, 0xde, 0x37, 0xf3, 0xc6,
+	0xfe, 0x60, 0x3b, 0xa1, 0x24, 0x64, 0x82, 0x53, 0x75, 0x2a, 0x92, 0x93, 0x16, 0x91, 0x92, 0xaa,
+	0xd6, 0xcb, 0xc7, 0x2d, 0x25, 0x4e, 0x28, 0x77, 0xe2, 0x44, 0x28, 0x81, 0xee, 0xcf, 0x70, 0x1c,
+	0xcd, 0x71, 0x5e, 0x3e, 0x36, 0xd7, 0x03, 0x11, 0x08, 0x4d, 0x69, 0x65, 0xab, 0x09, 0xdb, 0xdc,
+	0x0a, 0x84, 0x08, 0x42, 0xda, 0xd2, 0xd5, 0x28, 0x3d, 0x6e, 0x29, 0x16, 0x51, 0xa9, 0x48, 0x14,
+	0xe7, 0x84, 0xd6, 0x7f, 0xb5, 0x24, 0xa9, 0x1a, 0x8b, 0x84, 0xfd, 0x48, 0x14, 0x13, 0x79, 0xff,
+	0xed, 0xbf, 0x16, 0x61, 0x61, 0x98, 0x6d, 0x22, 0x04, 0x55, 0x4e, 0x22, 0x8a, 0x0d, 0xdb, 0x68,
+	0x2e, 0xbb, 0x7a, 0x8d, 0xee, 0xc3, 0xa2, 0x3c, 0x8f, 0x46, 0x22, 0xc4, 0x65, 0x8d, 0xe6, 0x15,
+	0x5a, 0x87, 0x05, 0x25, 0x14, 0x09, 0x71, 0x45, 0xc3, 0x93, 0x02, 0x7d, 0x02, 0x1b, 0x33, 0x2d,
+	0x5c, 0xfa, 0x43, 0xca, 0x12, 0xea, 0xe3, 0xaa, 0x6d, 0x34, 0x97, 0xdc, 0xf9, 0x9b, 0x08, 0xc3,
+	0xbd, 0x88, 0x70, 0x12, 0xd0, 0x04, 0x2f, 0x68, 0xb7, 0x69, 0x89, 0xbe, 0x02, 0x98, 0x4a, 0xa8,
+	0x8f, 0x17, 0xed, 0x4a, 0x73, 0xa5, 0xfd, 0xc8, 0x99, 0x1f, 0x98, 0xa3, 0x2f, 0xb1, 0x33, 0xd3,
+	0xe1, 0x86, 0x1a, 0x6d, 0xc2, 0x72, 0x44, 0xce, 0x8e, 0xd2, 0x38, 0x0e, 0xcf, 0xf1, 0x3d, 0xdd,
+	0xe7, 0x5f, 0x00, 0x3d, 0x84, 0x5a, 0x4c, 0xb9, 0xcf, 0x78, 0xb0, 0x9f, 0x1f, 0x65, 0x49, 0x53,
+	0x0a, 0x68, 0x96, 0x47, 0x4c, 0x52, 0x49, 0x7d, 0xbc, 0xac, 0xaf, 0x94, 0x57, 0xa8, 0x09, 0x75,
+	0x2f, 0x24, 0xa7, 0x23, 0xe2, 0x9d, 0xf4, 0x38, 0x19, 0x85, 0xd4, 0xc7, 0xa0, 0x09, 0x45, 0x18,
+	0x99, 0xb0, 0xe4, 0x53, 0x8f, 0x45, 0x24, 0x94, 0x78, 0xc5, 0x36, 0x9a, 0xab, 0xee, 0x3f, 0x35,
+	0xb2, 0x61, 0xc5, 0xa7, 0xd2, 0x4b, 0x58, 0x9c, 0x1d, 0x1f, 0xbf, 0xa5, 0x8f, 0x70, 0x13, 0x42,
+	0x0d, 0xa8, 0xa4, 0x09, 0xc3, 0xab, 0x7a, 0x27, 0x5b, 0x66, 0xe9, 0xa5, 0x09, 0x7b, 0x4a, 0xe4,
+	0x18, 0xd7, 0x26, 0xe9, 0xe5, 0x25, 0xb2, 0x00, 0x22, 0x72, 0xf6, 0x54, 0x84, 0x3e, 0x4d, 0x24,
+	0xae, 0xdb, 0x46, 0xb3, 0xea, 0xde, 0x40, 0xd0, 0x47, 0xb0, 0x16, 0x91, 0xb3, 0x0e, 0x09, 0x09,
+	0xf7, 0xe8, 0x21, 0x4d, 0x26, 0x38, 0x6e, 0x68, 0x97, 0x79, 0x5b, 0xe8, 0x1b, 0xa8, 0x8f, 0x42,
+	0xe2, 0x9d, 0x88, 0x54, 0x3d, 0x67, 0xdc, 0x17, 0xa7, 0x12, 0xbf, 0xad, 0x1f, 0xe5, 0xe1, 0x5d,
+	0x8f, 0xd2, 0x99, 0xa1, 0x77, 0xaa, 0xaf, 0x7f, 0xdf, 0x2a, 0xb9, 0x45, 0x13, 0xf4, 0x3d, 0xd4,
+	0x3d, 0x11, 0xc5, 0x21, 0xcb, 0xda, 0xb9, 0x69, 0x48, 0x25, 0x46, 0xda, 0xf7, 0x83, 0xbb, 0x7c,
+	0xbb, 0x33, 0xf4, 0xae, 0xe0, 0xc7, 0x2c, 0x98, 0xba, 0x17, 0xac, 0xd0, 0xd7, 0x50, 0x4f, 0xa8,
+	0x54, 0x09, 0xf3, 0xb2, 0x08, 0xf7, 0x85, 0x4f, 0xf1, 0x9a, 0x6d, 0x34, 0x6b, 0xed, 0xf7, 0xef,
+	0x72, 0x77, 0x67, 0xe9, 0x6e, 0x51, 0x9f, 0x45, 0xcb, 0x46, 0xde, 0xf4, 0xa5, 0xd7, 0xf5, 0x4b,
+	0xdf, 0x40, 0xd0, 0x7b, 0xb0, 0x2a, 0x12, 0x16, 0x30, 0xde, 0x1d, 0x13, 0xce, 0x69, 0x88, 0x37,
+	0x74, 0xa8, 0xb3, 0xe0, 0xf6, 0xe7, 0xb0, 0x3e, 0xef, 0x1e, 0xa8, 0x06, 0x65, 0xe6, 0xe7, 0xbf,
+	0x61, 0x99, 0xf9, 0x93, 0x8f, 0x2e, 0x21, 0x91, 0x9c, 0xfe, 0x84, 0x93, 0x6a, 0xfb, 0x27, 0x03,
+	0x6a, 0xb3, 0x01, 0xa3, 0x27, 0xb0, 0x20, 0x15, 0x49, 0x94, 0x56, 0xaf, 0xb4, 0x4d, 0x67, 0x32,
+	0x2f, 0x9c, 0xe9, 0xbc, 0x70, 0x86, 0xd3, 0x79, 0xd1, 0x59, 0xca, 0xd2, 0x7a, 0xf5, 0xc7, 0x96,
+	0xe1, 0x4e, 0x24, 0xe8, 0x53, 0xa8, 0x50, 0xee, 0xe3, 0xf2, 0xff, 0x50, 0x66, 0x82, 0x47, 0x3f,
+	0x97, 0xa1, 0x5e, 0x48, 0x0c, 0x7d, 0x01, 0x9b, 0x6e, 0xef, 0x68, 0xe8, 0xf6, 0xbb, 0xc3, 0xfe,
+	0xe0, 0xe0, 0xc5, 0xfe, 0x60, 0xb7, 0xf7, 0xe2, 0xd9, 0xc1, 0xd1, 0x61, 0xaf, 0xdb, 0xff, 0xb2,
+	0xdf, 0xdb, 0x6d, 0x94, 0x4c, 0xeb, 0xe2, 0xd2, 0x36, 0x0b, 0xb2, 0x67, 0x5c, 0xc6, 0xd4, 0x63,
+	0xc7, 0x8c, 0xfa, 0xa8, 0x0d, 0x1b, 0xb7, 0x1c, 0x06, 0x87, 0xbd, 0x83, 0x86, 0x61, 0x3e, 0xb8,
+	0xb8, 0xb4, 0xd7, 0x0a, 0xd2, 0x41, 0x4c, 0x39, 0xfa, 0x0c, 0xcc, 0x5b, 0x9a, 0x9d, 0xbd, 0xbd,
+	0xc1, 0xf3, 0xbd, 0xfe, 0xd1, 0xb0, 0x51, 0x36, 0x37, 0x2f, 0x2e, 0x6d, 0x5c, 0x10, 0xee, 0x84,
+	0xa1, 0x38, 0x0d, 0x99, 0x54, 0xe8, 0x09, 0xbc, 0x73, 0x4b, 0xbd, 0xdb, 0x3b, 0xf8, 0x56, 0x8b,
+	0x2b, 0xe6, 0xbb, 0x17, 0x97, 0xf6, 0x83, 0x82, 0x78, 0x97, 0xf2, 0xf3, 0x4c, 0x6b, 0x56, 0x7f,
+	0xf9, 0xd5, 0x2a, 0x75, 0xf6, 0x5e, 0x5f, 0x59, 0xc6, 0x9b, 0x2b, 0xcb, 0xf8, 0xf3, 0xca, 0x32,
+	0x5e, 0x5d, 0x5b, 0xa5, 0x37, 0xd7, 0x56, 0xe9, 0xb7, 0x6b, 0xab, 0xf4, 0x5d, 0x3b, 0x60, 0x6a,
+	0x9c, 0x8e, 0x1c, 0x4f, 0x44, 0xf9, 0x84, 0x56, 0xd4, 0x1b, 0xe7, 0xcb, 0x0f, 0xa7, 0xd3, 0xfa,
+	0x2c, 0x9f, 0xd7, 0xea, 0x3c, 0xa6, 0x72, 0xb4, 0xa8, 0xa3, 0xff, 0xf8, 0xef, 0x01, 0x00, 0xd1,
+	0xae, 0xf0, 0xbf, 0x46, 0x06, 0x00, 0x00,
 }
 
 func (m *Token) Marshal() (dAtA []byte, err error) {
@@ -158,6 +486,140 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OriginChannel) > 0 {
+		i -= len(m.OriginChannel)
+		copy(dAtA[i:], m.OriginChannel)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.OriginChannel)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.IbcEnabled {
+		i--
+		if m.IbcEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.RestrictionMode != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.RestrictionMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.ComplianceRules) > 0 {
+		for iNdEx := len(m.ComplianceRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ComplianceRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.BlackoutWindows) > 0 {
+		for iNdEx := len(m.BlackoutWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlackoutWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MaxBalancePerHolder) > 0 {
+		i -= len(m.MaxBalancePerHolder)
+		copy(dAtA[i:], m.MaxBalancePerHolder)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.MaxBalancePerHolder)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MaxHolders != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.MaxHolders))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.UriHash) > 0 {
+		i -= len(m.UriHash)
+		copy(dAtA[i:], m.UriHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.UriHash)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Uri) > 0 {
+		i -= len(m.Uri)
+		copy(dAtA[i:], m.Uri)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Uri)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ClawbackEnabled {
+		i--
+		if m.ClawbackEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PendingManager) > 0 {
+		i -= len(m.PendingManager)
+		copy(dAtA[i:], m.PendingManager)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.PendingManager)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MaxSupply) > 0 {
+		i -= len(m.MaxSupply)
+		copy(dAtA[i:], m.MaxSupply)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.MaxSupply)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Authorized) > 0 {
 		for iNdEx := len(m.Authorized) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -213,40 +675,116 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
-	offset -= sovToken(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ComplianceRuleConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Token) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ComplianceRuleConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ComplianceRuleConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	l = len(m.Total)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	if len(m.Params) > 0 {
+		i -= len(m.Params)
+		copy(dAtA[i:], m.Params)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Params)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AuthorizationRequired {
-		n += 2
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Manager)
-	if l > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *BlackoutWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlackoutWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlackoutWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.End):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintToken(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintToken(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovToken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.AuthorizationRequired {
+		n += 2
+	}
+	l = len(m.Manager)
+	if l > 0 {
 		n += 1 + l + sovToken(uint64(l))
 	}
 	if len(m.Authorized) > 0 {
@@ -255,6 +793,94 @@ func (m *Token) Size() (n int) {
 			n += 1 + l + sovToken(uint64(l))
 		}
 	}
+	l = len(m.MaxSupply)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.PendingManager)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.ClawbackEnabled {
+		n += 2
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovToken(uint64(m.Decimals))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Uri)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.UriHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.MaxHolders != 0 {
+		n += 1 + sovToken(uint64(m.MaxHolders))
+	}
+	l = len(m.MaxBalancePerHolder)
+	if l > 0 {
+		n += 2 + l + sovToken(uint64(l))
+	}
+	if len(m.BlackoutWindows) > 0 {
+		for _, e := range m.BlackoutWindows {
+			l = e.Size()
+			n += 2 + l + sovToken(uint64(l))
+		}
+	}
+	if len(m.ComplianceRules) > 0 {
+		for _, e := range m.ComplianceRules {
+			l = e.Size()
+			n += 2 + l + sovToken(uint64(l))
+		}
+	}
+	if m.RestrictionMode != 0 {
+		n += 2 + sovToken(uint64(m.RestrictionMode))
+	}
+	if m.IbcEnabled {
+		n += 3
+	}
+	l = len(m.OriginChannel)
+	if l > 0 {
+		n += 2 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *ComplianceRuleConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Params)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *BlackoutWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovToken(uint64(l))
 	return n
 }
 
@@ -475,6 +1101,645 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawbackEnabled = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UriHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UriHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHolders", wireType)
+			}
+			m.MaxHolders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHolders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBalancePerHolder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBalancePerHolder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackoutWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlackoutWindows = append(m.BlackoutWindows, BlackoutWindow{})
+			if err := m.BlackoutWindows[len(m.BlackoutWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplianceRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComplianceRules = append(m.ComplianceRules, ComplianceRuleConfig{})
+			if err := m.ComplianceRules[len(m.ComplianceRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictionMode", wireType)
+			}
+			m.RestrictionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestrictionMode |= RestrictionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcEnabled = bool(v != 0)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComplianceRuleConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComplianceRuleConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComplianceRuleConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlackoutWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlackoutWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlackoutWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
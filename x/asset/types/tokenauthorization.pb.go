@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +32,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type TokenAuthorization struct {
 	Address    string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	Authorized bool   `protobuf:"varint,3,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	Symbol     string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// expiry is the block time the authorization lapses at, none if unset
+	Expiry *time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
 }
 
 func (m *TokenAuthorization) Reset()         { *m = TokenAuthorization{} }
@@ -77,6 +84,20 @@ func (m *TokenAuthorization) GetAuthorized() bool {
 	return false
 }
 
+func (m *TokenAuthorization) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenAuthorization) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TokenAuthorization)(nil), "realionetwork.asset.v1.TokenAuthorization")
 }
@@ -86,20 +107,25 @@ func init() {
 }
 
 var fileDescriptor_082a161f7b2bd506 = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2f, 0x4a, 0x4d, 0xcc,
-	0xc9, 0xcc, 0xcf, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0xac,
-	0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x43, 0xd1, 0xa0,
-	0x07, 0xd6, 0xa0, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa2, 0x0f, 0x62,
-	0x41, 0x54, 0x2b, 0xf9, 0x71, 0x09, 0x85, 0x80, 0x4c, 0x72, 0x44, 0x36, 0x49, 0x48, 0x82, 0x8b,
-	0x3d, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x58, 0x82, 0x49, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc6,
-	0x15, 0x92, 0xe3, 0xe2, 0x82, 0x59, 0x9a, 0x9a, 0x22, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x11, 0x84,
-	0x24, 0xe2, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x46, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0x50, 0x3f, 0x95, 0xa4, 0x26, 0x67, 0x40,
-	0x99, 0xba, 0x30, 0xff, 0x55, 0x40, 0x7d, 0x58, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76,
-	0xa4, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xcd, 0x3b, 0xfc, 0x05, 0x01, 0x00, 0x00,
+	// 279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
+	0x14, 0xc5, 0xeb, 0xef, 0x2b, 0x01, 0xcc, 0x66, 0xa1, 0x2a, 0xca, 0xe0, 0x46, 0x4c, 0x59, 0xb0,
+	0xd5, 0xb2, 0xb0, 0xd2, 0x99, 0x29, 0xea, 0xc4, 0xe6, 0x34, 0x26, 0xb1, 0x9a, 0xe4, 0x46, 0xb6,
+	0x53, 0x1a, 0x9e, 0xa2, 0x8f, 0xc0, 0xe3, 0x30, 0x76, 0x64, 0x03, 0x25, 0x2f, 0x82, 0x9a, 0x3f,
+	0x52, 0xd9, 0xee, 0xb9, 0x3a, 0x47, 0xe7, 0xa7, 0x83, 0xb9, 0x96, 0x22, 0x53, 0x50, 0x48, 0xfb,
+	0x06, 0x7a, 0xcb, 0x85, 0x31, 0xd2, 0xf2, 0xdd, 0x82, 0x5b, 0xd8, 0xca, 0x42, 0x54, 0x36, 0x05,
+	0xad, 0xde, 0x85, 0x55, 0x50, 0xb0, 0x52, 0x83, 0x05, 0x32, 0xfb, 0x13, 0x60, 0x5d, 0x80, 0xed,
+	0x16, 0xde, 0x6d, 0x02, 0x09, 0x74, 0x16, 0x7e, 0xba, 0x7a, 0xb7, 0x37, 0x4f, 0x00, 0x92, 0x4c,
+	0xf2, 0x4e, 0x45, 0xd5, 0x2b, 0xb7, 0x2a, 0x97, 0xc6, 0x8a, 0xbc, 0xec, 0x0d, 0x77, 0x1f, 0x08,
+	0x93, 0xf5, 0xa9, 0xeb, 0xe9, 0xbc, 0x8b, 0xb8, 0xf8, 0x52, 0xc4, 0xb1, 0x96, 0xc6, 0xb8, 0xff,
+	0x7c, 0x14, 0x5c, 0x87, 0xa3, 0x24, 0x14, 0xe3, 0x11, 0x4b, 0xc6, 0xee, 0x7f, 0x1f, 0x05, 0x57,
+	0xe1, 0xd9, 0x87, 0xcc, 0xb0, 0x63, 0xea, 0x3c, 0x82, 0xcc, 0x9d, 0x76, 0xc1, 0x41, 0x91, 0x47,
+	0xec, 0xc8, 0x7d, 0xa9, 0x74, 0xed, 0x5e, 0xf8, 0x28, 0xb8, 0x59, 0x7a, 0xac, 0x47, 0x63, 0x23,
+	0x1a, 0x5b, 0x8f, 0x68, 0xab, 0xe9, 0xe1, 0x7b, 0x8e, 0xc2, 0xc1, 0xbf, 0x7a, 0xfe, 0x6c, 0x28,
+	0x3a, 0x36, 0x14, 0xfd, 0x34, 0x14, 0x1d, 0x5a, 0x3a, 0x39, 0xb6, 0x74, 0xf2, 0xd5, 0xd2, 0xc9,
+	0xcb, 0x32, 0x51, 0x36, 0xad, 0x22, 0xb6, 0x81, 0x7c, 0xd8, 0xd1, 0xca, 0x4d, 0x3a, 0x9c, 0xf7,
+	0xe3, 0xa6, 0xfb, 0x61, 0x55, 0x5b, 0x97, 0xd2, 0x44, 0x4e, 0xd7, 0xf7, 0xf0, 0x3b, 0x00, 0x33,
+	0xf5, 0xca, 0xfd, 0x79, 0x01, 0x00, 0x00,
 }
 
 func (m *TokenAuthorization) Marshal() (dAtA []byte, err error) {
@@ -122,6 +148,23 @@ func (m *TokenAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiry != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTokenauthorization(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTokenauthorization(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Authorized {
 		i--
 		if m.Authorized {
@@ -166,6 +209,14 @@ func (m *TokenAuthorization) Size() (n int) {
 	if m.Authorized {
 		n += 2
 	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTokenauthorization(uint64(l))
+	}
+	if m.Expiry != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTokenauthorization(uint64(l))
+	}
 	return n
 }
 
@@ -256,6 +307,74 @@ func (m *TokenAuthorization) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Authorized = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenauthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenauthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenauthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenauthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenauthorization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenauthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTokenauthorization(dAtA[iNdEx:])